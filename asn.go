@@ -0,0 +1,180 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func init() {
+	a := ASN("")
+	// register this format in the default registry
+	addBuiltin("asn", &a, IsASN)
+}
+
+// parseASN parses an AS number, with an optional "AS" prefix
+func parseASN(str string) (uint32, error) {
+	digits := strings.TrimPrefix(str, "AS")
+	if digits == "" || digits[0] < '1' || digits[0] > '9' {
+		return 0, fmt.Errorf("invalid AS number: %q", str)
+	}
+	n, err := strconv.ParseUint(digits, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid AS number: %q", str)
+	}
+	return uint32(n), nil
+}
+
+// IsASN returns true when the string is an Autonomous System Number, as a plain decimal number
+// (e.g. "12345") or with the "AS" prefix (e.g. "AS12345").
+//
+// Both 16-bit and 32-bit AS numbers are accepted, from 1 to 4294967295. Leading zeros are rejected.
+func IsASN(str string) bool {
+	_, err := parseASN(str)
+	return err == nil
+}
+
+// ASN represents an Autonomous System Number (e.g. "AS64496" or "64496")
+//
+// swagger:strfmt asn
+type ASN string
+
+// Number returns the AS number
+func (a ASN) Number() (uint32, error) {
+	return parseASN(string(a))
+}
+
+// Is16Bit returns true when this is a valid 16-bit AS number, from 1 to 65535
+func (a ASN) Is16Bit() bool {
+	n, err := a.Number()
+	return err == nil && n <= math.MaxUint16
+}
+
+// MarshalText turns this instance into text
+func (a ASN) MarshalText() ([]byte, error) {
+	return []byte(string(a)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (a *ASN) UnmarshalText(data []byte) error { // validation is performed later on
+	*a = ASN(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (a *ASN) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*a = ASN(string(v))
+	case string:
+		*a = ASN(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ASN from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (a ASN) Value() (driver.Value, error) {
+	return driver.Value(string(a)), nil
+}
+
+// String returns the canonical decimal form of this AS number, without the "AS" prefix (e.g. "64496").
+//
+// An invalid AS number is returned unchanged.
+func (a ASN) String() string {
+	n, err := a.Number()
+	if err != nil {
+		return string(a)
+	}
+	return strconv.FormatUint(uint64(n), 10)
+}
+
+// MarshalJSON returns the ASN as JSON
+func (a ASN) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(a))
+}
+
+// UnmarshalJSON sets the ASN from JSON
+func (a *ASN) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var astr string
+	if err := json.Unmarshal(data, &astr); err != nil {
+		return err
+	}
+	*a = ASN(astr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (a ASN) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": string(a)})
+}
+
+// UnmarshalBSON document into this value
+func (a *ASN) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(string); ok {
+		*a = ASN(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as ASN")
+}
+
+// MarshalBSONValue marshals this ASN as a BSON string
+func (a ASN) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(a))
+}
+
+// UnmarshalBSONValue reads this ASN from a BSON string
+func (a *ASN) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "ASN")
+	if err != nil {
+		return err
+	}
+	*a = ASN(str)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (a *ASN) DeepCopyInto(out *ASN) {
+	*out = *a
+}
+
+// DeepCopy copies the receiver into a new ASN.
+func (a *ASN) DeepCopy() *ASN {
+	if a == nil {
+		return nil
+	}
+	out := new(ASN)
+	a.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatASN(t *testing.T) {
+	a := ASN("AS64496")
+	str := "AS64511"
+	validASNs := []string{
+		"1",
+		"AS1",
+		"64496",
+		"AS65535",
+		"AS65536",
+		"AS4200000000",
+		"AS4294967295",
+		"4294967295",
+	}
+	invalidASNs := []string{
+		"",
+		"AS",
+		"0",
+		"AS0",
+		"AS00",
+		"AS012",
+		"AS4294967296",
+		"4294967296",
+		"AS-1",
+		"AS+1",
+		"as64496",
+		"AS 64496",
+		"ASN64496",
+		"AS1.10",
+	}
+
+	testStringFormat(t, &a, "asn", str, validASNs, invalidASNs)
+}
+
+func TestASN_Number(t *testing.T) {
+	for _, tc := range []struct {
+		asn      ASN
+		number   uint32
+		is16Bit  bool
+		expected string
+	}{
+		{"AS1", 1, true, "1"},
+		{"AS65535", 65535, true, "65535"},
+		{"65535", 65535, true, "65535"},
+		{"AS65536", 65536, false, "65536"},
+		{"AS4294967295", 4294967295, false, "4294967295"},
+	} {
+		n, err := tc.asn.Number()
+		require.NoErrorf(t, err, "unexpected error for %q", tc.asn)
+		assert.Equalf(t, tc.number, n, "unexpected number for %q", tc.asn)
+		assert.Equalf(t, tc.is16Bit, tc.asn.Is16Bit(), "unexpected 16-bit check for %q", tc.asn)
+		assert.Equalf(t, tc.expected, tc.asn.String(), "unexpected string for %q", tc.asn)
+	}
+
+	for _, invalid := range []ASN{"AS0", "AS4294967296", "ASX"} {
+		_, err := invalid.Number()
+		require.Errorf(t, err, "expected an error for %q", invalid)
+		assert.Falsef(t, invalid.Is16Bit(), "expected %q not to be a 16-bit AS number", invalid)
+		assert.Equal(t, string(invalid), invalid.String())
+	}
+}
+
+func TestDeepCopyASN(t *testing.T) {
+	a := ASN("AS64496")
+	in := &a
+
+	out := new(ASN)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *ASN
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
@@ -0,0 +1,225 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// iso3166Alpha2 lists the ISO 3166-1 alpha-2 country codes
+var iso3166Alpha2 = func() map[string]struct{} {
+	const codes = "AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ " +
+		"BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ " +
+		"CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ " +
+		"DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR " +
+		"GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY " +
+		"HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP " +
+		"KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY " +
+		"MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ " +
+		"NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY " +
+		"QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ " +
+		"TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ " +
+		"VA VC VE VG VI VN VU WF WS YE YT ZA ZM ZW"
+
+	m := make(map[string]struct{}, 250)
+	for _, code := range strings.Fields(codes) {
+		m[code] = struct{}{}
+	}
+	return m
+}()
+
+// isBICCountry tells whether the country code of a BIC is valid.
+//
+// Besides ISO 3166-1 country codes, SWIFT assigns BICs with the user-assigned code XK (Kosovo).
+func isBICCountry(code string) bool {
+	if code == "XK" {
+		return true
+	}
+	_, ok := iso3166Alpha2[code]
+	return ok
+}
+
+func init() {
+	b := BIC("")
+	// register this format in the default registry
+	addBuiltin("bic", &b, IsBIC)
+}
+
+// IsBIC returns true when the string is a valid ISO 9362 Business Identifier Code (SWIFT code),
+// e.g. "DEUTDEFF" or "DEUTDEFF500".
+//
+// A BIC has 8 or 11 upper case characters: a 4 letters bank code, a 2 letters ISO 3166-1 country code,
+// a 2 alphanumeric location code and an optional 3 alphanumeric branch code.
+func IsBIC(str string) bool {
+	if len(str) != 8 && len(str) != 11 {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		isLetter := c >= 'A' && c <= 'Z'
+		isDigit := c >= '0' && c <= '9'
+		if i < 6 && !isLetter || !isLetter && !isDigit {
+			return false
+		}
+	}
+	return isBICCountry(str[4:6])
+}
+
+// BIC represents a Business Identifier Code, also known as SWIFT code (e.g. "DEUTDEFF500")
+//
+// swagger:strfmt bic
+type BIC string
+
+// part returns the characters of this BIC in [from:to], or an empty string if the BIC is invalid
+func (b BIC) part(from, to int) string {
+	if !IsBIC(string(b)) || len(b) < to {
+		return ""
+	}
+	return string(b[from:to])
+}
+
+// BankCode returns the 4 letters bank (institution) code, or an empty string if the BIC is invalid
+func (b BIC) BankCode() string {
+	return b.part(0, 4)
+}
+
+// CountryCode returns the ISO 3166-1 country code, or an empty string if the BIC is invalid
+func (b BIC) CountryCode() string {
+	return b.part(4, 6)
+}
+
+// LocationCode returns the 2 characters location code, or an empty string if the BIC is invalid
+func (b BIC) LocationCode() string {
+	return b.part(6, 8)
+}
+
+// BranchCode returns the 3 characters branch code.
+//
+// It returns "XXX", which designates the primary office, when the BIC has 8 characters,
+// or an empty string if the BIC is invalid.
+func (b BIC) BranchCode() string {
+	if len(b) == 8 && IsBIC(string(b)) {
+		return "XXX"
+	}
+	return b.part(8, 11)
+}
+
+// MarshalText turns this instance into text
+func (b BIC) MarshalText() ([]byte, error) {
+	return []byte(string(b)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (b *BIC) UnmarshalText(data []byte) error { // validation is performed later on
+	*b = BIC(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (b *BIC) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*b = BIC(string(v))
+	case string:
+		*b = BIC(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.BIC from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (b BIC) Value() (driver.Value, error) {
+	return driver.Value(string(b)), nil
+}
+
+func (b BIC) String() string {
+	return string(b)
+}
+
+// MarshalJSON returns the BIC as JSON
+func (b BIC) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON sets the BIC from JSON
+func (b *BIC) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var bstr string
+	if err := json.Unmarshal(data, &bstr); err != nil {
+		return err
+	}
+	*b = BIC(bstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (b BIC) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": b.String()})
+}
+
+// UnmarshalBSON document into this value
+func (b *BIC) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(string); ok {
+		*b = BIC(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as BIC")
+}
+
+// MarshalBSONValue marshals this BIC as a BSON string
+func (b BIC) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(b))
+}
+
+// UnmarshalBSONValue reads this BIC from a BSON string
+func (b *BIC) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "BIC")
+	if err != nil {
+		return err
+	}
+	*b = BIC(str)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (b *BIC) DeepCopyInto(out *BIC) {
+	*out = *b
+}
+
+// DeepCopy copies the receiver into a new BIC.
+func (b *BIC) DeepCopy() *BIC {
+	if b == nil {
+		return nil
+	}
+	out := new(BIC)
+	b.DeepCopyInto(out)
+	return out
+}
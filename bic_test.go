@@ -0,0 +1,87 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBIC(t *testing.T) {
+	b := BIC("DEUTDEFF")
+	str := "DEUTDEFF500"
+	validBICs := []string{
+		"DEUTDEFF",
+		"DEUTDEFF500",
+		"BNPAFRPP",
+		"BNPAFRPPXXX",
+		"CHASUS33",
+		"NWBKGB2L",
+		"RBOSGGSX",
+		"BKCHCNBJ110",
+		"RAIFXKPR",
+	}
+	invalidBICs := []string{
+		"",
+		"DEUTDEF",
+		"DEUTDEFF5",
+		"DEUTDEFF5000",
+		"1EUTDEFF",
+		"DEU1DEFF",
+		"DEUTQQFF",
+		"DEUTD1FF",
+		"deutdeff",
+		"DEUTDEF-",
+		"DEUTDEFF50_",
+		"DEUT DEFF",
+	}
+
+	testStringFormat(t, &b, "bic", str, validBICs, invalidBICs)
+}
+
+func TestBIC_Parts(t *testing.T) {
+	for _, tc := range []struct {
+		bic                             BIC
+		bank, country, location, branch string
+	}{
+		{"DEUTDEFF500", "DEUT", "DE", "FF", "500"},
+		{"DEUTDEFF", "DEUT", "DE", "FF", "XXX"},
+		{"NWBKGB2L", "NWBK", "GB", "2L", "XXX"},
+		{"invalid", "", "", "", ""},
+		{"DEUTQQFF500", "", "", "", ""},
+	} {
+		assert.Equalf(t, tc.bank, tc.bic.BankCode(), "unexpected bank code for %q", tc.bic)
+		assert.Equalf(t, tc.country, tc.bic.CountryCode(), "unexpected country code for %q", tc.bic)
+		assert.Equalf(t, tc.location, tc.bic.LocationCode(), "unexpected location code for %q", tc.bic)
+		assert.Equalf(t, tc.branch, tc.bic.BranchCode(), "unexpected branch code for %q", tc.bic)
+	}
+}
+
+func TestDeepCopyBIC(t *testing.T) {
+	b := BIC("DEUTDEFF")
+	in := &b
+
+	out := new(BIC)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *BIC
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
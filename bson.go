@@ -27,7 +27,7 @@ import (
 func init() {
 	var id ObjectId
 	// register this format in the default registry
-	Default.Add("bsonobjectid", &id, IsBSONObjectID)
+	addBuiltin("bsonobjectid", &id, IsBSONObjectID)
 }
 
 // IsBSONObjectID returns true when the string is a valid BSON.ObjectId
@@ -50,6 +50,20 @@ func NewObjectId(hex string) ObjectId { //nolint:revive,stylecheck
 	return ObjectId(oid)
 }
 
+// ObjectIdFromBytes creates an ObjectId from its raw 12 bytes
+func ObjectIdFromBytes(b [12]byte) ObjectId { //nolint:revive,stylecheck
+	return ObjectId(b)
+}
+
+// ObjectIdValueAsBinary makes ObjectId.Value return the raw 12 bytes of the object id
+// instead of its hex string, for drivers which prefer binary storage.
+var ObjectIdValueAsBinary bool //nolint:revive,stylecheck
+
+// Bytes returns the raw 12 bytes of this object id
+func (id ObjectId) Bytes() [12]byte {
+	return id
+}
+
 // MarshalText turns this instance into text
 func (id ObjectId) MarshalText() ([]byte, error) {
 	oid := bsonprim.ObjectID(id)
@@ -74,23 +88,46 @@ func (id *ObjectId) UnmarshalText(data []byte) error { // validation is performe
 	return nil
 }
 
-// Scan read a value from a database driver
+// ScanBinary reads an object id from its raw 12 bytes
+func (id *ObjectId) ScanBinary(b []byte) error {
+	if len(b) != len(bsonprim.NilObjectID) {
+		return fmt.Errorf("cannot scan strfmt.ObjectId from %d bytes: expected %d bytes", len(b), len(bsonprim.NilObjectID))
+	}
+	copy(id[:], b)
+	return nil
+}
+
+// Scan read a value from a database driver.
+//
+// Besides hex strings, raw object ids are accepted as [12]byte values, or as 12 bytes long []byte values
+// (see ScanBinary). Other []byte values are read as hex strings.
 func (id *ObjectId) Scan(raw interface{}) error {
 	var data []byte
 	switch v := raw.(type) {
+	case [12]byte:
+		*id = ObjectId(v)
+		return nil
 	case []byte:
+		if len(v) == len(bsonprim.NilObjectID) {
+			return id.ScanBinary(v)
+		}
 		data = v
 	case string:
 		data = []byte(v)
 	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.URI from: %#v", v)
+		return fmt.Errorf("cannot sql.Scan() strfmt.ObjectId from: %#v", v)
 	}
 
 	return id.UnmarshalText(data)
 }
 
-// Value converts a value to a database driver value
+// Value converts a value to a database driver value.
+//
+// The value is the hex string of the object id, or its raw bytes when ObjectIdValueAsBinary is set.
 func (id ObjectId) Value() (driver.Value, error) {
+	if ObjectIdValueAsBinary {
+		return driver.Value(id[:]), nil
+	}
 	return driver.Value(bsonprim.ObjectID(id).Hex()), nil
 }
 
@@ -16,10 +16,12 @@ package strfmt
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
 )
 
 func TestBSONObjectId_fullCycle(t *testing.T) {
@@ -52,6 +54,63 @@ func TestBSONObjectId_fullCycle(t *testing.T) {
 	assert.Equal(t, id, idCopy)
 }
 
+func TestBSONObjectId_binary(t *testing.T) {
+	t.Cleanup(func() { ObjectIdValueAsBinary = false })
+
+	id := NewObjectId("507f1f77bcf86cd799439011")
+	raw := id.Bytes()
+	assert.Equal(t, [12]byte{0x50, 0x7f, 0x1f, 0x77, 0xbc, 0xf8, 0x6c, 0xd7, 0x99, 0x43, 0x90, 0x11}, raw)
+	assert.Equal(t, id, ObjectIdFromBytes(raw))
+
+	var idCopy ObjectId
+	require.NoError(t, idCopy.Scan(raw))
+	assert.Equal(t, id, idCopy)
+
+	val, err := id.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "507f1f77bcf86cd799439011", val)
+
+	ObjectIdValueAsBinary = true
+	val, err = id.Value()
+	require.NoError(t, err)
+	assert.Equal(t, raw[:], val)
+
+	idCopy = ObjectId{}
+	require.NoError(t, idCopy.Scan(val))
+	assert.Equal(t, id, idCopy)
+
+	require.Error(t, idCopy.Scan([]byte("507f1f77bcf86cd7994390")))
+	require.Error(t, idCopy.Scan(12))
+}
+
+func TestBSONObjectId_ScanBinary(t *testing.T) {
+	const hex = "507f1f77bcf86cd799439011"
+	raw := []byte{0x50, 0x7f, 0x1f, 0x77, 0xbc, 0xf8, 0x6c, 0xd7, 0x99, 0x43, 0x90, 0x11}
+
+	var id ObjectId
+	require.NoError(t, id.ScanBinary(raw))
+	assert.Equal(t, hex, id.String())
+
+	// Scan routes 12 bytes to ScanBinary and 24 bytes to hex decoding
+	id = ObjectId{}
+	require.NoError(t, id.Scan(raw))
+	assert.Equal(t, hex, id.String())
+	id = ObjectId{}
+	require.NoError(t, id.Scan([]byte(hex)))
+	assert.Equal(t, hex, id.String())
+
+	// the scanned bytes are copied
+	raw[0] = 0
+	assert.Equal(t, hex, id.String())
+
+	for _, invalid := range [][]byte{nil, {}, raw[:11], append(raw, 0)} {
+		id = NewObjectId(hex)
+		require.Error(t, id.ScanBinary(invalid))
+		assert.Equal(t, hex, id.String())
+	}
+	require.Error(t, id.Scan(nil))
+}
+
 func TestDeepCopyObjectId(t *testing.T) {
 	id := NewObjectId("507f1f77bcf86cd799439011")
 	in := &id
@@ -67,3 +126,138 @@ func TestDeepCopyObjectId(t *testing.T) {
 	out3 := inNil.DeepCopy()
 	assert.Nil(t, out3)
 }
+
+type bsonValueStruct struct {
+	Duration   Duration   `bson:"duration"`
+	URI        URI        `bson:"uri"`
+	Email      Email      `bson:"email"`
+	Hostname   Hostname   `bson:"hostname"`
+	IPv4       IPv4       `bson:"ipv4"`
+	IPv6       IPv6       `bson:"ipv6"`
+	CIDR       CIDR       `bson:"cidr"`
+	MAC        MAC        `bson:"mac"`
+	UUID       UUID       `bson:"uuid"`
+	HexColor   HexColor   `bson:"hexcolor"`
+	RGBColor   RGBColor   `bson:"rgbcolor"`
+	HSLColor   HSLColor   `bson:"hslcolor"`
+	SSN        SSN        `bson:"ssn"`
+	CreditCard CreditCard `bson:"creditcard"`
+	ISBN       ISBN       `bson:"isbn"`
+	Password   Password   `bson:"password"`
+	TimeZone   TimeZone   `bson:"timezone"`
+	Base64     Base64     `bson:"base64"`
+	Base64URL  Base64URL  `bson:"base64url"`
+	Date       Date       `bson:"date"`
+}
+
+func TestBSONValue_fullCycle(t *testing.T) {
+	in := bsonValueStruct{
+		Duration:   Duration(3 * time.Second),
+		URI:        URI("http://somewhere.com"),
+		Email:      Email("somebody@somewhere.com"),
+		Hostname:   Hostname("somewhere.com"),
+		IPv4:       IPv4("192.168.254.1"),
+		IPv6:       IPv6("::1"),
+		CIDR:       CIDR("192.0.2.1/24"),
+		MAC:        MAC("01:02:03:04:05:06"),
+		UUID:       UUID("a8098c1a-f86e-11da-bd1a-00112444be1e"),
+		HexColor:   HexColor("#FFFFFF"),
+		RGBColor:   RGBColor("rgb(255,255,255)"),
+		HSLColor:   HSLColor("hsl(0,0%,100%)"),
+		SSN:        SSN("111-11-1111"),
+		CreditCard: CreditCard("4111-1111-1111-1111"),
+		ISBN:       ISBN("0321751043"),
+		Password:   Password("super secret stuff here"),
+		TimeZone:   TimeZone("Europe/Paris"),
+		Base64:     Base64("hello, world"),
+		Base64URL:  Base64URL{0xfb, 0xff},
+		Date:       Date(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+	}
+
+	data, err := bson.Marshal(in)
+	require.NoError(t, err)
+
+	raw := bson.Raw(data)
+	assert.Equal(t, bson.TypeInt64, raw.Lookup("duration").Type)
+	assert.Equal(t, int64(3*time.Second), raw.Lookup("duration").Int64())
+	for _, key := range []string{"uri", "email", "hostname", "ipv4", "ipv6", "cidr", "mac", "uuid", "hexcolor", "rgbcolor", "hslcolor", "ssn", "creditcard", "isbn", "password", "timezone"} {
+		assert.Equalf(t, bson.TypeString, raw.Lookup(key).Type, "expected %q to be rendered as a BSON string", key)
+	}
+	assert.Equal(t, "somebody@somewhere.com", raw.Lookup("email").StringValue())
+	assert.Equal(t, bson.TypeDateTime, raw.Lookup("date").Type)
+	subtype, b := raw.Lookup("base64").Binary()
+	assert.Equal(t, bsontype.BinaryGeneric, subtype)
+	assert.Equal(t, []byte("hello, world"), b)
+
+	var out bsonValueStruct
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestBSONValue_legacyDocuments(t *testing.T) {
+	// values rendered as {"data": ...} documents by MarshalBSON may still be read
+	legacy := bson.M{
+		"duration":  bson.M{"data": "3s"},
+		"email":     bson.M{"data": "somebody@somewhere.com"},
+		"base64":    bson.M{"data": "aGVsbG8="},
+		"base64url": bson.M{"data": "-_8="},
+		"date":      bson.M{"data": "2024-01-15"},
+	}
+	data, err := bson.Marshal(legacy)
+	require.NoError(t, err)
+
+	var out bsonValueStruct
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.Equal(t, Duration(3*time.Second), out.Duration)
+	assert.Equal(t, Email("somebody@somewhere.com"), out.Email)
+	assert.Equal(t, Base64("hello"), out.Base64)
+	assert.Equal(t, Base64URL{0xfb, 0xff}, out.Base64URL)
+	assert.Equal(t, "2024-01-15", out.Date.String())
+}
+
+func TestBSONDuration_StringFallback(t *testing.T) {
+	type job struct {
+		Timeout Duration `bson:"timeout"`
+	}
+
+	for _, legacy := range []interface{}{"1m30s", "90 seconds", bson.M{"data": "1m30s"}, int64(90 * time.Second)} {
+		data, err := bson.Marshal(bson.M{"timeout": legacy})
+		require.NoError(t, err)
+
+		var out job
+		require.NoErrorf(t, bson.Unmarshal(data, &out), "could not decode %v", legacy)
+		assert.Equalf(t, Duration(90*time.Second), out.Timeout, "unexpected duration decoded from %v", legacy)
+
+		// the duration is written back as int64 nanoseconds
+		data, err = bson.Marshal(out)
+		require.NoError(t, err)
+		value := bson.Raw(data).Lookup("timeout")
+		assert.Equal(t, bson.TypeInt64, value.Type)
+		assert.Equal(t, int64(90*time.Second), value.Int64())
+	}
+}
+
+func TestBSONValue_errors(t *testing.T) {
+	for _, doc := range []bson.M{
+		{"duration": "not a duration"},
+		{"duration": 3.5},
+		{"email": int32(1)},
+		{"email": bson.M{"other": "somebody@somewhere.com"}},
+		{"base64": "aGVsbG8="},
+		{"base64": bson.M{"data": "not base64!"}},
+	} {
+		data, err := bson.Marshal(doc)
+		require.NoError(t, err)
+
+		var out bsonValueStruct
+		require.Errorf(t, bson.Unmarshal(data, &out), "expected an error decoding %v", doc)
+	}
+
+	data, err := bson.Marshal(bson.M{"duration": int32(42), "email": nil, "base64": nil})
+	require.NoError(t, err)
+	out := bsonValueStruct{Email: "somebody@somewhere.com", Base64: Base64("hello")}
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.Equal(t, Duration(42), out.Duration)
+	assert.Empty(t, out.Email)
+	assert.Empty(t, out.Base64)
+}
@@ -0,0 +1,129 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import "time"
+
+// BusinessDayCalculator tells which dates are business days, e.g. to take a holiday calendar into account.
+type BusinessDayCalculator interface {
+	IsBusinessDay(d Date) bool
+}
+
+// BusinessDayFunc adapts a function to a BusinessDayCalculator
+type BusinessDayFunc func(d Date) bool
+
+// IsBusinessDay calls fn(d)
+func (fn BusinessDayFunc) IsBusinessDay(d Date) bool {
+	return fn(d)
+}
+
+// DefaultBusinessDayCalculator considers all weekdays as business days, and skips weekends
+var DefaultBusinessDayCalculator BusinessDayCalculator = BusinessDayFunc(isWeekday)
+
+func isWeekday(d Date) bool {
+	switch time.Time(d).Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	default:
+		return true
+	}
+}
+
+type businessDayOptions struct {
+	calculator BusinessDayCalculator
+}
+
+// BusinessDayOption configures business day arithmetic
+type BusinessDayOption func(*businessDayOptions)
+
+// WithBusinessDayCalculator sets the calculator telling which dates are business days.
+//
+// The default is DefaultBusinessDayCalculator.
+func WithBusinessDayCalculator(calculator BusinessDayCalculator) BusinessDayOption {
+	return func(o *businessDayOptions) {
+		o.calculator = calculator
+	}
+}
+
+func businessDayCalculator(opts []BusinessDayOption) BusinessDayCalculator {
+	o := businessDayOptions{calculator: DefaultBusinessDayCalculator}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return o.calculator
+}
+
+// BusinessDays counts the business days from d (included) to end (excluded).
+//
+// The count is negative when end is before d, e.g. from friday to the previous monday is -4.
+func (d Date) BusinessDays(end Date, opts ...BusinessDayOption) int {
+	calculator := businessDayCalculator(opts)
+	from, to, sign := dayOf(d), dayOf(end), 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+
+	var count int
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if calculator.IsBusinessDay(Date(day)) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+// maxNonBusinessDays is the number of consecutive days without a business day after which AddBusinessDays gives up
+const maxNonBusinessDays = 366
+
+// firstBusinessDay and lastBusinessDay bound the dates searched by AddBusinessDays to the years of RFC 3339 full-dates
+var (
+	firstBusinessDay = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	lastBusinessDay  = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// AddBusinessDays returns the date n business days after d, or before d when n is negative.
+//
+// E.g. with the DefaultBusinessDayCalculator, adding 1 business day to a friday yields the next monday.
+// d is returned unchanged when n is zero, even if it is not a business day.
+//
+// d is returned unchanged as well when the calculator accepts no date for a whole year in the direction of the search,
+// or when the result would fall outside the years 0001 to 9999.
+func (d Date) AddBusinessDays(n int, opts ...BusinessDayOption) Date {
+	calculator := businessDayCalculator(opts)
+	step := 1
+	if n < 0 {
+		step = -1
+	}
+
+	// n is counted down by steps rather than negated, which would overflow for math.MinInt
+	day, days, idle := dayOf(d), 0, 0
+	for n != 0 {
+		day = day.AddDate(0, 0, step)
+		days += step
+		if day.Before(firstBusinessDay) || day.After(lastBusinessDay) {
+			return d
+		}
+		if calculator.IsBusinessDay(Date(day)) {
+			n -= step
+			idle = 0
+			continue
+		}
+		idle++
+		if idle >= maxNonBusinessDays {
+			return d
+		}
+	}
+	return Date(time.Time(d).AddDate(0, 0, days))
+}
@@ -0,0 +1,110 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newYearHolidays skips weekends, as well as Christmas and the New Year's day
+var newYearHolidays = BusinessDayFunc(func(d Date) bool {
+	tm := time.Time(d)
+	if (tm.Month() == time.December && tm.Day() == 25) || (tm.Month() == time.January && tm.Day() == 1) {
+		return false
+	}
+	return DefaultBusinessDayCalculator.IsBusinessDay(d)
+})
+
+func TestDate_BusinessDays(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		start, end string
+		calculator BusinessDayCalculator
+		expected   int
+	}{
+		{name: "same day", start: "2024-06-05", end: "2024-06-05", expected: 0},
+		{name: "one weekday", start: "2024-06-05", end: "2024-06-06", expected: 1},
+		{name: "full week", start: "2024-06-03", end: "2024-06-10", expected: 5},
+		{name: "from friday to monday", start: "2024-06-07", end: "2024-06-10", expected: 1},
+		{name: "from saturday to monday", start: "2024-06-08", end: "2024-06-10", expected: 0},
+		{name: "backwards", start: "2024-06-07", end: "2024-06-03", expected: -4},
+		{name: "end of year", start: "2024-12-30", end: "2025-01-06", expected: 5},
+		{name: "end of year with holidays", start: "2024-12-23", end: "2025-01-06", calculator: newYearHolidays, expected: 8},
+		{name: "leap year", start: "2024-02-26", end: "2024-03-04", expected: 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts []BusinessDayOption
+			if tc.calculator != nil {
+				opts = append(opts, WithBusinessDayCalculator(tc.calculator))
+			}
+			assert.Equal(t, tc.expected, testDate(t, tc.start).BusinessDays(testDate(t, tc.end), opts...))
+		})
+	}
+}
+
+func TestDate_AddBusinessDays(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		start      string
+		n          int
+		calculator BusinessDayCalculator
+		expected   string
+	}{
+		{name: "zero", start: "2024-06-08", n: 0, expected: "2024-06-08"},
+		{name: "next day", start: "2024-06-05", n: 1, expected: "2024-06-06"},
+		{name: "from friday", start: "2024-06-07", n: 1, expected: "2024-06-10"},
+		{name: "from saturday", start: "2024-06-08", n: 1, expected: "2024-06-10"},
+		{name: "two weeks", start: "2024-06-03", n: 10, expected: "2024-06-17"},
+		{name: "backwards from monday", start: "2024-06-10", n: -1, expected: "2024-06-07"},
+		{name: "backwards from sunday", start: "2024-06-09", n: -5, expected: "2024-06-03"},
+		{name: "over new year", start: "2024-12-30", n: 3, expected: "2025-01-02"},
+		{name: "over new year with holidays", start: "2024-12-24", n: 2, calculator: newYearHolidays, expected: "2024-12-27"},
+		{name: "back over new year with holidays", start: "2025-01-02", n: -3, calculator: newYearHolidays, expected: "2024-12-27"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts []BusinessDayOption
+			if tc.calculator != nil {
+				opts = append(opts, WithBusinessDayCalculator(tc.calculator))
+			}
+			start := testDate(t, tc.start)
+			actual := start.AddBusinessDays(tc.n, opts...)
+			assert.Equal(t, tc.expected, actual.String())
+			if tc.n != 0 && businessDayCalculator(opts).IsBusinessDay(start) {
+				assert.Equal(t, tc.n, start.BusinessDays(actual, opts...))
+			}
+		})
+	}
+
+	t.Run("without business days", func(t *testing.T) {
+		never := WithBusinessDayCalculator(BusinessDayFunc(func(Date) bool { return false }))
+		start := testDate(t, "2024-06-07")
+		assert.Equal(t, start, start.AddBusinessDays(1, never))
+		assert.Equal(t, start, start.AddBusinessDays(-1, never))
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		start := testDate(t, "2024-06-07")
+		assert.Equal(t, start, start.AddBusinessDays(math.MinInt))
+		assert.Equal(t, start, start.AddBusinessDays(math.MaxInt))
+
+		always := WithBusinessDayCalculator(BusinessDayFunc(func(Date) bool { return true }))
+		assert.Equal(t, "0001-01-01", testDate(t, "0001-01-02").AddBusinessDays(-1, always).String())
+		assert.Equal(t, "0001-01-02", testDate(t, "0001-01-02").AddBusinessDays(-2, always).String())
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// maxCIDRHostBits is the largest number of host bits of a CIDR block which hosts may be listed by CIDR.Hosts
+const maxCIDRHostBits = 16
+
+// prefix parses this CIDR block and returns it with its host bits cleared
+func (u CIDR) prefix() (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(string(u))
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return p.Masked(), nil
+}
+
+// PrefixLen returns the length of the network prefix of this CIDR block, e.g. 24 for "192.168.0.0/24"
+func (u CIDR) PrefixLen() (int, error) {
+	p, err := u.prefix()
+	if err != nil {
+		return 0, err
+	}
+	return p.Bits(), nil
+}
+
+// PrefixLength returns the length of the network prefix of this CIDR block, like PrefixLen
+func (u CIDR) PrefixLength() (int, error) {
+	return u.PrefixLen()
+}
+
+// IP returns the IP address of this CIDR, as written before the prefix length.
+//
+// E.g. the IP of "192.168.1.77/24" is "192.168.1.77": use NetworkAddress to get "192.168.1.0".
+func (u CIDR) IP() (string, error) {
+	p, err := netip.ParsePrefix(string(u))
+	if err != nil {
+		return "", err
+	}
+	return p.Addr().String(), nil
+}
+
+// networkOf returns the CIDR block of the specified length which contains an IP address
+func networkOf(ip string, is4 bool, prefixLen int) (CIDR, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return "", err
+	}
+	if is4 {
+		addr = addr.Unmap()
+		if !addr.Is4() {
+			return "", fmt.Errorf("%q is not an IPv4 address", ip)
+		}
+	} else if !addr.Is6() {
+		return "", fmt.Errorf("%q is not an IPv6 address", ip)
+	}
+	if prefixLen < 0 || prefixLen > addr.BitLen() {
+		return "", fmt.Errorf("invalid prefix length %d: must be between 0 and %d", prefixLen, addr.BitLen())
+	}
+	p, err := addr.Prefix(prefixLen)
+	if err != nil {
+		return "", err
+	}
+	return CIDR(p.String()), nil
+}
+
+// Network returns the CIDR block with the specified prefix length, in [0,32], which contains this address.
+//
+// E.g. the network of "192.168.1.77" with a prefix length of 24 is "192.168.1.0/24".
+func (u IPv4) Network(prefixLen int) (CIDR, error) {
+	return networkOf(string(u), true, prefixLen)
+}
+
+// Network returns the CIDR block with the specified prefix length, in [0,128], which contains this address.
+//
+// E.g. the network of "2001:db8::1" with a prefix length of 32 is "2001:db8::/32".
+func (u IPv6) Network(prefixLen int) (CIDR, error) {
+	return networkOf(string(u), false, prefixLen)
+}
+
+// NetworkAddress returns the first address of this CIDR block, or an empty string when the CIDR is invalid
+func (u CIDR) NetworkAddress() string {
+	p, err := u.prefix()
+	if err != nil {
+		return ""
+	}
+	return p.Addr().String()
+}
+
+// BroadcastAddress returns the last address of this CIDR block, or an empty string when the CIDR is invalid.
+//
+// IPv6 has no broadcast address: the last address of the block is returned nonetheless.
+func (u CIDR) BroadcastAddress() string {
+	p, err := u.prefix()
+	if err != nil {
+		return ""
+	}
+	return lastAddr(p).String()
+}
+
+// lastAddr returns the last address of a masked prefix
+func lastAddr(p netip.Prefix) netip.Addr {
+	b := p.Addr().AsSlice()
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// Hosts lists the host addresses of this CIDR block.
+//
+// For IPv4, the network and broadcast addresses are skipped, except for /31 and /32 blocks
+// which have no such addresses. IPv6 blocks are listed in full.
+//
+// Hosts is meant for small subnets: an error is returned when the block has more than 16 host bits,
+// i.e. for IPv4 prefixes shorter than /16 and IPv6 prefixes shorter than /112.
+// Full enumeration of larger blocks, and notably of IPv6 networks, is not supported: use HostsIter instead.
+func (u CIDR) Hosts() ([]string, error) {
+	p, err := u.prefix()
+	if err != nil {
+		return nil, err
+	}
+	if p.Addr().BitLen()-p.Bits() > maxCIDRHostBits {
+		return nil, fmt.Errorf("cidr %s has too many hosts to be listed: prefix length must be at least %d",
+			u, p.Addr().BitLen()-maxCIDRHostBits)
+	}
+
+	var hosts []string
+	eachCIDRHost(p, func(host string) bool {
+		hosts = append(hosts, host)
+		return true
+	})
+	return hosts, nil
+}
+
+// HostsIter returns an iterator over the host addresses of this CIDR block, with the same rules as Hosts.
+//
+// Addresses are computed as the iteration proceeds, so that large blocks may be iterated upon.
+// Nothing is yielded when the CIDR is invalid.
+//
+// With Go 1.23 or later, the iterator may be used in a range loop: for host := range cidr.HostsIter() {...}.
+func (u CIDR) HostsIter() func(yield func(string) bool) {
+	return func(yield func(string) bool) {
+		p, err := u.prefix()
+		if err != nil {
+			return
+		}
+		eachCIDRHost(p, yield)
+	}
+}
+
+// eachCIDRHost calls yield with each host address of a masked prefix, until yield returns false
+func eachCIDRHost(p netip.Prefix, yield func(string) bool) {
+	first, last := p.Addr(), lastAddr(p)
+	if first.Is4() && p.Bits() < 31 {
+		first, last = first.Next(), last.Prev()
+	}
+	for addr := first; ; addr = addr.Next() {
+		if !yield(addr.String()) || addr == last {
+			return
+		}
+	}
+}
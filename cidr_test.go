@@ -0,0 +1,156 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCIDR_Addresses(t *testing.T) {
+	cidr := CIDR("192.168.1.77/24")
+
+	bits, err := cidr.PrefixLen()
+	require.NoError(t, err)
+	assert.Equal(t, 24, bits)
+	assert.Equal(t, "192.168.1.0", cidr.NetworkAddress())
+	assert.Equal(t, "192.168.1.255", cidr.BroadcastAddress())
+
+	v6 := CIDR("2001:db8::/32")
+	bits, err = v6.PrefixLen()
+	require.NoError(t, err)
+	assert.Equal(t, 32, bits)
+	assert.Equal(t, "2001:db8::", v6.NetworkAddress())
+	assert.Equal(t, "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", v6.BroadcastAddress())
+
+	invalid := CIDR("192.168.1.0")
+	_, err = invalid.PrefixLen()
+	require.Error(t, err)
+	assert.Empty(t, invalid.NetworkAddress())
+	assert.Empty(t, invalid.BroadcastAddress())
+}
+
+func TestCIDR_Hosts(t *testing.T) {
+	t.Run("should skip IPv4 network and broadcast addresses", func(t *testing.T) {
+		hosts, err := CIDR("10.0.0.0/29").Hosts()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"}, hosts)
+
+		hosts, err = CIDR("10.0.0.0/31").Hosts()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.0", "10.0.0.1"}, hosts)
+
+		hosts, err = CIDR("10.0.0.9/32").Hosts()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.9"}, hosts)
+
+		hosts, err = CIDR("10.0.0.0/16").Hosts()
+		require.NoError(t, err)
+		assert.Len(t, hosts, 1<<16-2)
+	})
+
+	t.Run("should list IPv6 addresses", func(t *testing.T) {
+		hosts, err := CIDR("2001:db8::/126").Hosts()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2001:db8::", "2001:db8::1", "2001:db8::2", "2001:db8::3"}, hosts)
+
+		hosts, err = CIDR("ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffe/127").Hosts()
+		require.NoError(t, err)
+		assert.Len(t, hosts, 2)
+	})
+
+	t.Run("should refuse large blocks", func(t *testing.T) {
+		_, err := CIDR("10.0.0.0/15").Hosts()
+		require.Error(t, err)
+		_, err = CIDR("2001:db8::/64").Hosts()
+		require.Error(t, err)
+		_, err = CIDR("nope").Hosts()
+		require.Error(t, err)
+	})
+}
+
+func TestCIDR_HostsIter(t *testing.T) {
+	var hosts []string
+	CIDR("10.0.0.0/8").HostsIter()(func(host string) bool {
+		hosts = append(hosts, host)
+		return len(hosts) < 3
+	})
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, hosts)
+
+	hosts = nil
+	CIDR("2001:db8::/32").HostsIter()(func(host string) bool {
+		hosts = append(hosts, host)
+		return len(hosts) < 2
+	})
+	assert.Equal(t, []string{"2001:db8::", "2001:db8::1"}, hosts)
+
+	called := false
+	CIDR("invalid").HostsIter()(func(string) bool {
+		called = true
+		return true
+	})
+	assert.False(t, called)
+}
+
+func TestIP_Network(t *testing.T) {
+	for _, tc := range []struct {
+		ip        string
+		prefixLen int
+		expected  CIDR
+	}{
+		{"192.168.1.77", 24, "192.168.1.0/24"},
+		{"192.168.1.77", 32, "192.168.1.77/32"},
+		{"192.168.1.77", 0, "0.0.0.0/0"},
+		{"10.1.2.3", 12, "10.0.0.0/12"},
+	} {
+		cidr, err := IPv4(tc.ip).Network(tc.prefixLen)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, cidr)
+
+		// round trip
+		ip, err := CIDR(tc.ip + "/" + strconv.Itoa(tc.prefixLen)).IP()
+		require.NoError(t, err)
+		assert.Equal(t, tc.ip, ip)
+		bits, err := cidr.PrefixLength()
+		require.NoError(t, err)
+		assert.Equal(t, tc.prefixLen, bits)
+	}
+
+	cidr, err := IPv6("2001:db8::1").Network(32)
+	require.NoError(t, err)
+	assert.Equal(t, CIDR("2001:db8::/32"), cidr)
+	cidr, err = IPv6("2001:db8::1").Network(128)
+	require.NoError(t, err)
+	assert.Equal(t, CIDR("2001:db8::1/128"), cidr)
+
+	for _, bits := range []int{-1, 33} {
+		_, err = IPv4("192.168.1.77").Network(bits)
+		require.Error(t, err)
+	}
+	_, err = IPv6("2001:db8::1").Network(129)
+	require.Error(t, err)
+	_, err = IPv4("2001:db8::1").Network(24)
+	require.Error(t, err)
+	_, err = IPv6("192.168.1.77").Network(24)
+	require.Error(t, err)
+	_, err = IPv4("nope").Network(24)
+	require.Error(t, err)
+
+	_, err = CIDR("192.168.1.77").IP()
+	require.Error(t, err)
+}
@@ -0,0 +1,240 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func init() {
+	cl := CIDRList(nil)
+	// register this format in the default registry
+	addBuiltin("cidr-list", &cl, IsCIDRList)
+}
+
+// splitCIDRList splits a list of CIDRs separated by commas or new lines, and validates them.
+//
+// Blank entries are ignored.
+func splitCIDRList(str string) (CIDRList, error) {
+	fields := strings.FieldsFunc(str, func(r rune) bool { return r == ',' || r == '\n' || r == '\r' })
+	var list CIDRList
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !govalidator.IsCIDR(field) {
+			return nil, fmt.Errorf("invalid cidr in list: %q", field)
+		}
+		list = append(list, CIDR(field))
+	}
+	return list, nil
+}
+
+// IsCIDRList returns true when the string is a list of CIDRs separated by commas or new lines
+func IsCIDRList(str string) bool {
+	_, err := splitCIDRList(str)
+	return err == nil
+}
+
+// CIDRList represents a list of CIDR blocks (e.g. an allowlist), separated by new lines or commas
+//
+// Unlike most formats, the elements of the list are validated when unmarshaled.
+// The list is rendered as a BSON array of strings.
+//
+// swagger:strfmt cidr-list
+type CIDRList []CIDR
+
+// ContainsIP returns true when the IP address belongs to any of the CIDR blocks of the list.
+//
+// An error is returned when the IP address, or a CIDR of the list, is not valid.
+func (l CIDRList) ContainsIP(ip string) (bool, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false, err
+	}
+	addr = addr.Unmap()
+
+	for _, cidr := range l {
+		p, err := cidr.prefix()
+		if err != nil {
+			return false, err
+		}
+		if p.Contains(addr) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Normalize returns the CIDR blocks of the list in their canonical form (e.g. "192.168.1.0/24" for "192.168.1.77/24"),
+// without duplicates, and sorted by network address then prefix length. IPv4 blocks come first.
+//
+// Invalid CIDRs are kept, without duplicates, at the end of the list.
+func (l CIDRList) Normalize() CIDRList {
+	prefixes := make([]netip.Prefix, 0, len(l))
+	seen := make(map[string]struct{}, len(l))
+	var invalid CIDRList
+	for _, cidr := range l {
+		p, err := cidr.prefix()
+		if err != nil {
+			if _, dup := seen[string(cidr)]; !dup {
+				seen[string(cidr)] = struct{}{}
+				invalid = append(invalid, cidr)
+			}
+			continue
+		}
+		if _, dup := seen[p.String()]; !dup {
+			seen[p.String()] = struct{}{}
+			prefixes = append(prefixes, p)
+		}
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+
+	out := make(CIDRList, 0, len(prefixes)+len(invalid))
+	for _, p := range prefixes {
+		out = append(out, CIDR(p.String()))
+	}
+	return append(out, invalid...)
+}
+
+// strings returns the CIDRs of the list as strings
+func (l CIDRList) strings() []string {
+	items := make([]string, 0, len(l))
+	for _, cidr := range l {
+		items = append(items, string(cidr))
+	}
+	return items
+}
+
+// MarshalText turns this instance into text, with one CIDR per line
+func (l CIDRList) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text, and validates the elements of the list
+func (l *CIDRList) UnmarshalText(data []byte) error {
+	list, err := splitCIDRList(string(data))
+	if err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// Scan read a value from a database driver
+func (l *CIDRList) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return l.UnmarshalText(v)
+	case string:
+		return l.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.CIDRList from: %#v", v)
+	}
+}
+
+// Value converts a value to a database driver value
+func (l CIDRList) Value() (driver.Value, error) {
+	return driver.Value(l.String()), nil
+}
+
+func (l CIDRList) String() string {
+	return strings.Join(l.strings(), "\n")
+}
+
+// MarshalJSON returns the CIDRList as a JSON string
+func (l CIDRList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON sets the CIDRList from a JSON string, or from a JSON array of strings
+func (l *CIDRList) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var items []string
+	if err := json.Unmarshal(data, &items); err == nil {
+		return l.UnmarshalText([]byte(strings.Join(items, "\n")))
+	}
+	var lstr string
+	if err := json.Unmarshal(data, &lstr); err != nil {
+		return err
+	}
+	return l.UnmarshalText([]byte(lstr))
+}
+
+// MarshalBSON document from this value
+func (l CIDRList) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": l.strings()})
+}
+
+// UnmarshalBSON document into this value
+func (l *CIDRList) UnmarshalBSON(data []byte) error {
+	raw, err := bson.Raw(data).LookupErr("data")
+	if err != nil {
+		return errors.New("couldn't unmarshal bson bytes as CIDRList")
+	}
+	return l.UnmarshalBSONValue(raw.Type, raw.Value)
+}
+
+// MarshalBSONValue marshals this CIDRList as a BSON array of strings
+func (l CIDRList) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(l.strings())
+}
+
+// UnmarshalBSONValue reads this CIDRList from a BSON array of strings
+func (l *CIDRList) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	if tpe == bson.TypeNull {
+		*l = nil
+		return nil
+	}
+	var items []string
+	if err := (bson.RawValue{Type: tpe, Value: data}).Unmarshal(&items); err != nil {
+		return fmt.Errorf("couldn't unmarshal bson value of type %s as CIDRList: %w", tpe, err)
+	}
+	return l.UnmarshalText([]byte(strings.Join(items, "\n")))
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (l *CIDRList) DeepCopyInto(out *CIDRList) {
+	*out = append(CIDRList(nil), *l...)
+}
+
+// DeepCopy copies the receiver into a new CIDRList.
+func (l *CIDRList) DeepCopy() *CIDRList {
+	if l == nil {
+		return nil
+	}
+	out := new(CIDRList)
+	l.DeepCopyInto(out)
+	return out
+}
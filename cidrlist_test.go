@@ -0,0 +1,147 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var _ testableFormat = &CIDRList{}
+
+func TestFormatCIDRList(t *testing.T) {
+	const str = "10.0.0.0/8\n192.168.1.0/24\n2001:db8::/32"
+	expected := CIDRList{"10.0.0.0/8", "192.168.1.0/24", "2001:db8::/32"}
+
+	var l CIDRList
+	require.NoError(t, l.UnmarshalText([]byte(" 10.0.0.0/8, 192.168.1.0/24\r\n\n2001:db8::/32\n")))
+	assert.Equal(t, expected, l)
+	b, err := l.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, str, string(b))
+	assert.Equal(t, str, l.String())
+
+	b, err = l.MarshalJSON()
+	require.NoError(t, err)
+	l = nil
+	require.NoError(t, l.UnmarshalJSON(b))
+	assert.Equal(t, expected, l)
+	l = nil
+	require.NoError(t, l.UnmarshalJSON([]byte(`["10.0.0.0/8","192.168.1.0/24","2001:db8::/32"]`)))
+	assert.Equal(t, expected, l)
+	require.NoError(t, l.UnmarshalJSON([]byte(jsonNull)))
+	assert.Equal(t, expected, l)
+	require.Error(t, l.UnmarshalJSON([]byte(`["10.0.0.0/8","nope"]`)))
+	require.Error(t, l.UnmarshalJSON([]byte(`10`)))
+
+	var scanned CIDRList
+	require.NoError(t, scanned.Scan(str))
+	assert.Equal(t, expected, scanned)
+	require.NoError(t, scanned.Scan([]byte(str)))
+	assert.Equal(t, expected, scanned)
+	require.Error(t, scanned.Scan(123))
+	v, err := scanned.Value()
+	require.NoError(t, err)
+	assert.Equal(t, str, v)
+
+	require.Error(t, l.UnmarshalText([]byte("10.0.0.0/8,10.0.0.1")))
+
+	for _, valid := range []string{str, "", "10.0.0.0/8, 192.168.1.0/24"} {
+		testValid(t, "cidr-list", valid)
+	}
+	for _, invalid := range []string{"10.0.0.0/8; 192.168.1.0/24", "10.0.0.0/33", "10.0.0.1"} {
+		testInvalid(t, "cidr-list", invalid)
+	}
+}
+
+func TestCIDRList_BSON(t *testing.T) {
+	l := CIDRList{"10.0.0.0/8", "2001:db8::/32"}
+
+	data, err := bson.Marshal(l)
+	require.NoError(t, err)
+	items, err := bson.Raw(data).Lookup("data").Array().Values()
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "10.0.0.0/8", items[0].StringValue())
+
+	var fromDoc CIDRList
+	require.NoError(t, bson.Unmarshal(data, &fromDoc))
+	assert.Equal(t, l, fromDoc)
+
+	type allowlist struct {
+		Networks CIDRList `bson:"networks"`
+	}
+	data, err = bson.Marshal(allowlist{Networks: l})
+	require.NoError(t, err)
+	assert.Equal(t, bson.TypeArray, bson.Raw(data).Lookup("networks").Type)
+
+	var out allowlist
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.Equal(t, l, out.Networks)
+
+	data, err = bson.Marshal(bson.M{"networks": "10.0.0.0/8"})
+	require.NoError(t, err)
+	require.Error(t, bson.Unmarshal(data, &out))
+}
+
+func TestCIDRList_ContainsIP(t *testing.T) {
+	l := CIDRList{"10.0.0.0/8", "192.168.1.0/24", "2001:db8::/32"}
+
+	for _, tc := range []struct {
+		ip       string
+		contains bool
+	}{
+		{"10.1.2.3", true},
+		{"192.168.1.255", true},
+		{"192.168.2.1", false},
+		{"::ffff:10.0.0.1", true},
+		{"2001:db8::1", true},
+		{"2001:db9::1", false},
+	} {
+		contains, err := l.ContainsIP(tc.ip)
+		require.NoError(t, err)
+		assert.Equalf(t, tc.contains, contains, "unexpected result for %s", tc.ip)
+	}
+
+	_, err := l.ContainsIP("nope")
+	require.Error(t, err)
+	_, err = CIDRList{"nope"}.ContainsIP("10.0.0.1")
+	require.Error(t, err)
+}
+
+func TestCIDRList_Normalize(t *testing.T) {
+	l := CIDRList{"2001:db8::1/32", "192.168.1.77/24", "invalid", "10.0.0.0/8", "192.168.1.0/24", "10.0.0.0/16", "invalid"}
+	assert.Equal(t, CIDRList{"10.0.0.0/8", "10.0.0.0/16", "192.168.1.0/24", "2001:db8::/32", "invalid"}, l.Normalize())
+	assert.Empty(t, CIDRList(nil).Normalize())
+}
+
+func TestDeepCopyCIDRList(t *testing.T) {
+	l := CIDRList{"10.0.0.0/8"}
+	in := &l
+
+	out := new(CIDRList)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *CIDRList
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
@@ -0,0 +1,112 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// FormatEncoder encodes the text representation of a format value for some wire format
+type FormatEncoder interface {
+	EncodeFormat(name string, value string) ([]byte, error)
+}
+
+// FormatDecoder decodes the text representation of a format value from some wire format
+type FormatDecoder interface {
+	DecodeFormat(name string, encoded []byte) (string, error)
+}
+
+// JSONFormatCodec encodes format values as JSON strings, like the MarshalJSON methods of the formats.
+//
+// This is the default codec of registries.
+type JSONFormatCodec struct{}
+
+// EncodeFormat encodes the value as a JSON string
+func (JSONFormatCodec) EncodeFormat(_ string, value string) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// DecodeFormat decodes a JSON string
+func (JSONFormatCodec) DecodeFormat(name string, encoded []byte) (string, error) {
+	var value string
+	if err := json.Unmarshal(encoded, &value); err != nil {
+		return "", fmt.Errorf("cannot decode %s from JSON: %w", name, err)
+	}
+	return value, nil
+}
+
+// msgpack string formats
+const (
+	msgpackFixStr = 0xa0
+	msgpackStr8   = 0xd9
+	msgpackStr16  = 0xda
+	msgpackStr32  = 0xdb
+)
+
+// MsgpackFormatCodec encodes format values as MessagePack strings.
+//
+// Only the str family of the MessagePack specification is needed to represent format values:
+// it is implemented directly, without depending on a MessagePack library.
+type MsgpackFormatCodec struct{}
+
+// EncodeFormat encodes the value as a MessagePack string
+func (MsgpackFormatCodec) EncodeFormat(name string, value string) ([]byte, error) {
+	n := len(value)
+	var buf []byte
+	switch {
+	case n < 32:
+		buf = append(make([]byte, 0, 1+n), msgpackFixStr|byte(n))
+	case n <= math.MaxUint8:
+		buf = append(make([]byte, 0, 2+n), msgpackStr8, byte(n))
+	case n <= math.MaxUint16:
+		buf = binary.BigEndian.AppendUint16(append(make([]byte, 0, 3+n), msgpackStr16), uint16(n))
+	case uint64(n) <= math.MaxUint32:
+		buf = binary.BigEndian.AppendUint32(append(make([]byte, 0, 5+n), msgpackStr32), uint32(n))
+	default:
+		return nil, fmt.Errorf("cannot encode %s as MessagePack: value too long", name)
+	}
+	return append(buf, value...), nil
+}
+
+// DecodeFormat decodes a MessagePack string
+func (MsgpackFormatCodec) DecodeFormat(name string, encoded []byte) (string, error) {
+	if len(encoded) == 0 {
+		return "", fmt.Errorf("cannot decode %s from MessagePack: %w", name, errors.New("empty input"))
+	}
+
+	var n uint64
+	header := 1
+	switch b := encoded[0]; {
+	case b&0xe0 == msgpackFixStr:
+		n = uint64(b & 0x1f)
+	case b == msgpackStr8 && len(encoded) >= 2:
+		n, header = uint64(encoded[1]), 2
+	case b == msgpackStr16 && len(encoded) >= 3:
+		n, header = uint64(binary.BigEndian.Uint16(encoded[1:])), 3
+	case b == msgpackStr32 && len(encoded) >= 5:
+		n, header = uint64(binary.BigEndian.Uint32(encoded[1:])), 5
+	default:
+		return "", fmt.Errorf("cannot decode %s from MessagePack: not a string (type byte 0x%02x)", name, b)
+	}
+
+	if uint64(len(encoded)-header) != n {
+		return "", fmt.Errorf("cannot decode %s from MessagePack: expected %d bytes of string, got %d", name, n, len(encoded)-header)
+	}
+	return string(encoded[header:]), nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgpackFormatCodec(t *testing.T) {
+	var codec MsgpackFormatCodec
+	for _, tc := range []struct {
+		value  string
+		header []byte
+	}{
+		{"", []byte{0xa0}},
+		{"2024-01-01", []byte{0xaa}},
+		{strings.Repeat("x", 31), []byte{0xbf}},
+		{strings.Repeat("x", 32), []byte{0xd9, 0x20}},
+		{strings.Repeat("x", 256), []byte{0xda, 0x01, 0x00}},
+		{strings.Repeat("x", 1<<16), []byte{0xdb, 0x00, 0x01, 0x00, 0x00}},
+	} {
+		encoded, err := codec.EncodeFormat("test", tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.header, encoded[:len(tc.header)])
+		assert.Len(t, encoded, len(tc.header)+len(tc.value))
+
+		decoded, err := codec.DecodeFormat("test", encoded)
+		require.NoError(t, err)
+		assert.Equal(t, tc.value, decoded)
+	}
+
+	for _, invalid := range [][]byte{
+		nil,
+		{0xc0},
+		{0xa2, 'x'},
+		{0xa1, 'x', 'y'},
+		{0xd9},
+		{0xda, 0x00},
+	} {
+		_, err := codec.DecodeFormat("test", invalid)
+		require.Errorf(t, err, "expected %x to be rejected", invalid)
+	}
+}
+
+func TestFormatRegistry_Codecs(t *testing.T) {
+	registry := asFormatRegistry(t, NewFormats())
+	d := testDate(t, "2024-02-29")
+
+	t.Run("should encode as JSON by default", func(t *testing.T) {
+		encoded, err := registry.Encode("date", &d)
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-02-29"`, string(encoded))
+
+		decoded, err := registry.Decode("date", encoded)
+		require.NoError(t, err)
+		assert.Equal(t, &d, decoded)
+
+		_, err = registry.Decode("date", []byte(`2024`))
+		require.Error(t, err)
+		_, err = registry.Decode("date", []byte(`"2024"`))
+		require.Error(t, err)
+	})
+
+	t.Run("should encode with a custom codec", func(t *testing.T) {
+		registry.SetEncoder(MsgpackFormatCodec{})
+		registry.SetDecoder(MsgpackFormatCodec{})
+
+		encoded, err := registry.Encode("date", &d)
+		require.NoError(t, err)
+		assert.Equal(t, append([]byte{0xaa}, "2024-02-29"...), encoded)
+
+		decoded, err := registry.Decode("date", encoded)
+		require.NoError(t, err)
+		assert.Equal(t, &d, decoded)
+
+		registry.SetEncoder(nil)
+		encoded, err = registry.Encode("date", &d)
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-02-29"`, string(encoded))
+	})
+
+	t.Run("should reject unknown formats", func(t *testing.T) {
+		_, err := registry.Encode("unknown", &d)
+		require.Error(t, err)
+		_, err = registry.Decode("unknown", []byte(`"x"`))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// BIC returns a pointer to of the BIC value passed in.
+func BIC(v strfmt.BIC) *strfmt.BIC {
+	return &v
+}
+
+// BICValue returns the value of the BIC pointer passed in or
+// the default value if the pointer is nil.
+func BICValue(v *strfmt.BIC) strfmt.BIC {
+	if v == nil {
+		return strfmt.BIC("")
+	}
+
+	return *v
+}
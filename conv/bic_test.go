@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestBICValue(t *testing.T) {
+	assert.Equal(t, strfmt.BIC(""), BICValue(nil))
+	value := strfmt.BIC("DEUTDEFF")
+	assert.Equal(t, value, BICValue(&value))
+	assert.Equal(t, &value, BIC(value))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// ObjectId returns a pointer to of the ObjectId value passed in.
+func ObjectId(v strfmt.ObjectId) *strfmt.ObjectId {
+	return &v
+}
+
+// ObjectIdValue returns the value of the ObjectId pointer passed in or
+// the default value if the pointer is nil.
+func ObjectIdValue(v *strfmt.ObjectId) strfmt.ObjectId {
+	if v == nil {
+		return strfmt.ObjectId{}
+	}
+
+	return *v
+}
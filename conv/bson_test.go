@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestObjectIdValue(t *testing.T) {
+	assert.Equal(t, strfmt.ObjectId{}, ObjectIdValue(nil))
+	value := strfmt.NewObjectId("507f1f77bcf86cd799439011")
+	assert.Equal(t, value, ObjectIdValue(&value))
+	assert.Equal(t, &value, ObjectId(value))
+}
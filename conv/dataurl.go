@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// DataURL returns a pointer to of the DataURL value passed in.
+func DataURL(v strfmt.DataURL) *strfmt.DataURL {
+	return &v
+}
+
+// DataURLValue returns the value of the DataURL pointer passed in or
+// the default value if the pointer is nil.
+func DataURLValue(v *strfmt.DataURL) strfmt.DataURL {
+	if v == nil {
+		return strfmt.DataURL("")
+	}
+
+	return *v
+}
@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestDataURLValue(t *testing.T) {
+	assert.Equal(t, strfmt.DataURL(""), DataURLValue(nil))
+	value := strfmt.DataURL("data:,Hello")
+	assert.Equal(t, value, DataURLValue(&value))
+	assert.Equal(t, &value, DataURL(value))
+}
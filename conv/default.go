@@ -19,6 +19,36 @@ func Base64Value(v *strfmt.Base64) strfmt.Base64 {
 	return *v
 }
 
+// Base64Std returns a pointer to of the Base64Std value passed in.
+func Base64Std(v strfmt.Base64Std) *strfmt.Base64Std {
+	return &v
+}
+
+// Base64StdValue returns the value of the Base64Std pointer passed in or
+// the default value if the pointer is nil.
+func Base64StdValue(v *strfmt.Base64Std) strfmt.Base64Std {
+	if v == nil {
+		return nil
+	}
+
+	return *v
+}
+
+// Base64URL returns a pointer to of the Base64URL value passed in.
+func Base64URL(v strfmt.Base64URL) *strfmt.Base64URL {
+	return &v
+}
+
+// Base64URLValue returns the value of the Base64URL pointer passed in or
+// the default value if the pointer is nil.
+func Base64URLValue(v *strfmt.Base64URL) strfmt.Base64URL {
+	if v == nil {
+		return nil
+	}
+
+	return *v
+}
+
 // URI returns a pointer to of the URI value passed in.
 func URI(v strfmt.URI) *strfmt.URI {
 	return &v
@@ -184,6 +214,36 @@ func UUID5Value(v *strfmt.UUID5) strfmt.UUID5 {
 	return *v
 }
 
+// UUID6 returns a pointer to of the UUID6 value passed in.
+func UUID6(v strfmt.UUID6) *strfmt.UUID6 {
+	return &v
+}
+
+// UUID6Value returns the value of the UUID6 pointer passed in or
+// the default value if the pointer is nil.
+func UUID6Value(v *strfmt.UUID6) strfmt.UUID6 {
+	if v == nil {
+		return strfmt.UUID6("")
+	}
+
+	return *v
+}
+
+// UUID7 returns a pointer to of the UUID7 value passed in.
+func UUID7(v strfmt.UUID7) *strfmt.UUID7 {
+	return &v
+}
+
+// UUID7Value returns the value of the UUID7 pointer passed in or
+// the default value if the pointer is nil.
+func UUID7Value(v *strfmt.UUID7) strfmt.UUID7 {
+	if v == nil {
+		return strfmt.UUID7("")
+	}
+
+	return *v
+}
+
 // ISBN returns a pointer to of the ISBN value passed in.
 func ISBN(v strfmt.ISBN) *strfmt.ISBN {
 	return &v
@@ -14,6 +14,18 @@ func TestBase64Value(t *testing.T) {
 	assert.Equal(t, base64, Base64Value(&base64))
 }
 
+func TestBase64StdValue(t *testing.T) {
+	assert.Equal(t, strfmt.Base64Std(nil), Base64StdValue(nil))
+	value := strfmt.Base64Std([]byte{4, 2})
+	assert.Equal(t, value, Base64StdValue(&value))
+}
+
+func TestBase64URLValue(t *testing.T) {
+	assert.Equal(t, strfmt.Base64URL(nil), Base64URLValue(nil))
+	value := strfmt.Base64URL([]byte{4, 2})
+	assert.Equal(t, value, Base64URLValue(&value))
+}
+
 func TestURIValue(t *testing.T) {
 	assert.Equal(t, strfmt.URI(""), URIValue(nil))
 	value := strfmt.URI("foo")
@@ -74,6 +86,18 @@ func TestUUID4Value(t *testing.T) {
 	assert.Equal(t, value, UUID4Value(&value))
 }
 
+func TestUUID6Value(t *testing.T) {
+	assert.Equal(t, strfmt.UUID6(""), UUID6Value(nil))
+	value := strfmt.UUID6("foo")
+	assert.Equal(t, value, UUID6Value(&value))
+}
+
+func TestUUID7Value(t *testing.T) {
+	assert.Equal(t, strfmt.UUID7(""), UUID7Value(nil))
+	value := strfmt.UUID7("foo")
+	assert.Equal(t, value, UUID7Value(&value))
+}
+
 func TestUUID5Value(t *testing.T) {
 	assert.Equal(t, strfmt.UUID5(""), UUID5Value(nil))
 	value := strfmt.UUID5("foo")
@@ -0,0 +1,48 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// Epoch returns a pointer to of the Epoch value passed in.
+func Epoch(v strfmt.Epoch) *strfmt.Epoch {
+	return &v
+}
+
+// EpochValue returns the value of the Epoch pointer passed in or
+// the default value if the pointer is nil.
+func EpochValue(v *strfmt.Epoch) strfmt.Epoch {
+	if v == nil {
+		return strfmt.Epoch(0)
+	}
+
+	return *v
+}
+
+// EpochMillis returns a pointer to of the EpochMillis value passed in.
+func EpochMillis(v strfmt.EpochMillis) *strfmt.EpochMillis {
+	return &v
+}
+
+// EpochMillisValue returns the value of the EpochMillis pointer passed in or
+// the default value if the pointer is nil.
+func EpochMillisValue(v *strfmt.EpochMillis) strfmt.EpochMillis {
+	if v == nil {
+		return strfmt.EpochMillis(0)
+	}
+
+	return *v
+}
+
+// EpochNanos returns a pointer to of the EpochNanos value passed in.
+func EpochNanos(v strfmt.EpochNanos) *strfmt.EpochNanos {
+	return &v
+}
+
+// EpochNanosValue returns the value of the EpochNanos pointer passed in or
+// the default value if the pointer is nil.
+func EpochNanosValue(v *strfmt.EpochNanos) strfmt.EpochNanos {
+	if v == nil {
+		return strfmt.EpochNanos(0)
+	}
+
+	return *v
+}
@@ -0,0 +1,27 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestEpochValue(t *testing.T) {
+	assert.Equal(t, strfmt.Epoch(0), EpochValue(nil))
+	e := strfmt.Epoch(1700000000)
+	assert.Equal(t, e, EpochValue(&e))
+}
+
+func TestEpochMillisValue(t *testing.T) {
+	assert.Equal(t, strfmt.EpochMillis(0), EpochMillisValue(nil))
+	e := strfmt.EpochMillis(1700000000123)
+	assert.Equal(t, e, EpochMillisValue(&e))
+}
+
+func TestEpochNanosValue(t *testing.T) {
+	assert.Equal(t, strfmt.EpochNanos(0), EpochNanosValue(nil))
+	e := strfmt.EpochNanos(1700000000123456789)
+	assert.Equal(t, e, EpochNanosValue(&e))
+}
@@ -0,0 +1,51 @@
+//go:build go1.18
+
+// Package generic provides type parameterized alternatives to the helpers of the conv package,
+// converting slices of any strfmt type to and from slices of pointers.
+//
+// The type specific slice helpers of the conv package, such as conv.UUIDSlice, are built on this package.
+package generic
+
+// Pointers converts a slice of values into a slice of pointers to copies of these values
+func Pointers[T any](vs []T) []*T {
+	if vs == nil {
+		return nil
+	}
+	out := make([]*T, len(vs))
+	for i := range vs {
+		v := vs[i]
+		out[i] = &v
+	}
+	return out
+}
+
+// Values converts a slice of pointers into a slice of values.
+// nil pointers are converted to zero.
+func Values[T any](vs []*T, zero T) []T {
+	if vs == nil {
+		return nil
+	}
+	out := make([]T, len(vs))
+	for i, v := range vs {
+		if v == nil {
+			out[i] = zero
+			continue
+		}
+		out[i] = *v
+	}
+	return out
+}
+
+// FilterNil returns a new slice with the non-nil pointers of vs, in the same order
+func FilterNil[T any](vs []*T) []*T {
+	if vs == nil {
+		return nil
+	}
+	out := make([]*T, 0, len(vs))
+	for _, v := range vs {
+		if v != nil {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,94 @@
+//go:build go1.18
+
+package generic_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/strfmt/conv/generic"
+)
+
+func TestPointers(t *testing.T) {
+	values := []strfmt.UUID{"a8098c1a-f86e-11da-bd1a-00112444be1e", ""}
+	ptrs := generic.Pointers(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, values[0], *ptrs[0])
+	assert.Equal(t, values[1], *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Empty(t, generic.Pointers([]strfmt.Date{}))
+	assert.NotNil(t, generic.Pointers([]strfmt.Date{}))
+	assert.Nil(t, generic.Pointers[strfmt.Date](nil))
+}
+
+func TestValues(t *testing.T) {
+	value := strfmt.Duration(time.Minute)
+	assert.Equal(t,
+		[]strfmt.Duration{value, strfmt.Duration(0)},
+		generic.Values([]*strfmt.Duration{&value, nil}, strfmt.Duration(0)),
+	)
+	assert.Equal(t,
+		[]strfmt.Duration{value, strfmt.Duration(time.Second)},
+		generic.Values([]*strfmt.Duration{&value, nil}, strfmt.Duration(time.Second)),
+	)
+	assert.Nil(t, generic.Values(nil, strfmt.Duration(0)))
+}
+
+func TestFilterNil(t *testing.T) {
+	first, second := strfmt.Email("a@example.com"), strfmt.Email("b@example.com")
+	filtered := generic.FilterNil([]*strfmt.Email{nil, &first, nil, &second, nil})
+	assert.Equal(t, []*strfmt.Email{&first, &second}, filtered)
+	assert.Same(t, &first, filtered[0])
+
+	assert.Empty(t, generic.FilterNil([]*strfmt.Email{nil, nil}))
+	assert.Nil(t, generic.FilterNil[strfmt.Email](nil))
+}
+
+func benchmarkUUIDs(n int) ([]strfmt.UUID, []*strfmt.UUID) {
+	values := make([]strfmt.UUID, n)
+	for i := range values {
+		values[i] = strfmt.UUID("a8098c1a-f86e-11da-bd1a-" + strconv.Itoa(100000000000+i))
+	}
+	return values, conv.UUIDPtrSlice(values)
+}
+
+func BenchmarkValues(b *testing.B) {
+	_, ptrs := benchmarkUUIDs(1000)
+
+	b.Run("generic", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = generic.Values(ptrs, strfmt.UUID(""))
+		}
+	})
+
+	b.Run("conv.UUIDSlice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = conv.UUIDSlice(ptrs)
+		}
+	})
+}
+
+func BenchmarkPointers(b *testing.B) {
+	values, _ := benchmarkUUIDs(1000)
+
+	b.Run("generic", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = generic.Pointers(values)
+		}
+	})
+
+	b.Run("conv.UUIDPtrSlice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = conv.UUIDPtrSlice(values)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// GeoCoordinate returns a pointer to of the GeoCoordinate value passed in.
+func GeoCoordinate(v strfmt.GeoCoordinate) *strfmt.GeoCoordinate {
+	return &v
+}
+
+// GeoCoordinateValue returns the value of the GeoCoordinate pointer passed in or
+// the default value if the pointer is nil.
+func GeoCoordinateValue(v *strfmt.GeoCoordinate) strfmt.GeoCoordinate {
+	if v == nil {
+		return strfmt.GeoCoordinate("")
+	}
+
+	return *v
+}
@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestGeoCoordinateValue(t *testing.T) {
+	assert.Equal(t, strfmt.GeoCoordinate(""), GeoCoordinateValue(nil))
+	value := strfmt.GeoCoordinate("48.8566,2.3522")
+	assert.Equal(t, value, GeoCoordinateValue(&value))
+	assert.Equal(t, &value, GeoCoordinate(value))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// HexString returns a pointer to of the HexString value passed in.
+func HexString(v strfmt.HexString) *strfmt.HexString {
+	return &v
+}
+
+// HexStringValue returns the value of the HexString pointer passed in or
+// the default value if the pointer is nil.
+func HexStringValue(v *strfmt.HexString) strfmt.HexString {
+	if v == nil {
+		return strfmt.HexString("")
+	}
+
+	return *v
+}
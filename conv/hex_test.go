@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestHexStringValue(t *testing.T) {
+	assert.Equal(t, strfmt.HexString(""), HexStringValue(nil))
+	value := strfmt.HexString("cafe")
+	assert.Equal(t, value, HexStringValue(&value))
+	assert.Equal(t, &value, HexString(value))
+}
@@ -0,0 +1,33 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// IDNHostname returns a pointer to of the IDNHostname value passed in.
+func IDNHostname(v strfmt.IDNHostname) *strfmt.IDNHostname {
+	return &v
+}
+
+// IDNHostnameValue returns the value of the IDNHostname pointer passed in or
+// the default value if the pointer is nil.
+func IDNHostnameValue(v *strfmt.IDNHostname) strfmt.IDNHostname {
+	if v == nil {
+		return strfmt.IDNHostname("")
+	}
+
+	return *v
+}
+
+// IDNEmail returns a pointer to of the IDNEmail value passed in.
+func IDNEmail(v strfmt.IDNEmail) *strfmt.IDNEmail {
+	return &v
+}
+
+// IDNEmailValue returns the value of the IDNEmail pointer passed in or
+// the default value if the pointer is nil.
+func IDNEmailValue(v *strfmt.IDNEmail) strfmt.IDNEmail {
+	if v == nil {
+		return strfmt.IDNEmail("")
+	}
+
+	return *v
+}
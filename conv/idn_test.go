@@ -0,0 +1,23 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestIDNHostnameValue(t *testing.T) {
+	assert.Equal(t, strfmt.IDNHostname(""), IDNHostnameValue(nil))
+	value := strfmt.IDNHostname("bücher.example.com")
+	assert.Equal(t, value, IDNHostnameValue(&value))
+	assert.Equal(t, &value, IDNHostname(value))
+}
+
+func TestIDNEmailValue(t *testing.T) {
+	assert.Equal(t, strfmt.IDNEmail(""), IDNEmailValue(nil))
+	value := strfmt.IDNEmail("josé@bücher.example.com")
+	assert.Equal(t, value, IDNEmailValue(&value))
+	assert.Equal(t, &value, IDNEmail(value))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// JSONPointer returns a pointer to of the JSONPointer value passed in.
+func JSONPointer(v strfmt.JSONPointer) *strfmt.JSONPointer {
+	return &v
+}
+
+// JSONPointerValue returns the value of the JSONPointer pointer passed in or
+// the default value if the pointer is nil.
+func JSONPointerValue(v *strfmt.JSONPointer) strfmt.JSONPointer {
+	if v == nil {
+		return strfmt.JSONPointer("")
+	}
+
+	return *v
+}
@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestJSONPointerValue(t *testing.T) {
+	assert.Equal(t, strfmt.JSONPointer(""), JSONPointerValue(nil))
+	value := strfmt.JSONPointer("/foo/bar")
+	assert.Equal(t, value, JSONPointerValue(&value))
+	assert.Equal(t, &value, JSONPointer(value))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// MimeType returns a pointer to of the MimeType value passed in.
+func MimeType(v strfmt.MimeType) *strfmt.MimeType {
+	return &v
+}
+
+// MimeTypeValue returns the value of the MimeType pointer passed in or
+// the default value if the pointer is nil.
+func MimeTypeValue(v *strfmt.MimeType) strfmt.MimeType {
+	if v == nil {
+		return strfmt.MimeType("")
+	}
+
+	return *v
+}
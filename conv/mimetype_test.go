@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestMimeTypeValue(t *testing.T) {
+	assert.Equal(t, strfmt.MimeType(""), MimeTypeValue(nil))
+	value := strfmt.MimeType("application/json")
+	assert.Equal(t, value, MimeTypeValue(&value))
+	assert.Equal(t, &value, MimeType(value))
+}
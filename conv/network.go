@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// NetworkAddress returns a pointer to of the NetworkAddress value passed in.
+func NetworkAddress(v strfmt.NetworkAddress) *strfmt.NetworkAddress {
+	return &v
+}
+
+// NetworkAddressValue returns the value of the NetworkAddress pointer passed in or
+// the default value if the pointer is nil.
+func NetworkAddressValue(v *strfmt.NetworkAddress) strfmt.NetworkAddress {
+	if v == nil {
+		return strfmt.NetworkAddress("")
+	}
+
+	return *v
+}
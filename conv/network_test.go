@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestNetworkAddressValue(t *testing.T) {
+	assert.Equal(t, strfmt.NetworkAddress(""), NetworkAddressValue(nil))
+	value := strfmt.NetworkAddress("localhost:8080")
+	assert.Equal(t, value, NetworkAddressValue(&value))
+	assert.Equal(t, &value, NetworkAddress(value))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// PortNumber returns a pointer to of the PortNumber value passed in.
+func PortNumber(v strfmt.PortNumber) *strfmt.PortNumber {
+	return &v
+}
+
+// PortNumberValue returns the value of the PortNumber pointer passed in or
+// the default value if the pointer is nil.
+func PortNumberValue(v *strfmt.PortNumber) strfmt.PortNumber {
+	if v == nil {
+		return strfmt.PortNumber(0)
+	}
+
+	return *v
+}
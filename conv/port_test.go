@@ -0,0 +1,15 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestPortNumberValue(t *testing.T) {
+	assert.Equal(t, strfmt.PortNumber(0), PortNumberValue(nil))
+	port := strfmt.PortNumber(8080)
+	assert.Equal(t, port, PortNumberValue(&port))
+}
@@ -0,0 +1,33 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// URIReference returns a pointer to of the URIReference value passed in.
+func URIReference(v strfmt.URIReference) *strfmt.URIReference {
+	return &v
+}
+
+// URIReferenceValue returns the value of the URIReference pointer passed in or
+// the default value if the pointer is nil.
+func URIReferenceValue(v *strfmt.URIReference) strfmt.URIReference {
+	if v == nil {
+		return strfmt.URIReference("")
+	}
+
+	return *v
+}
+
+// IRIReference returns a pointer to of the IRIReference value passed in.
+func IRIReference(v strfmt.IRIReference) *strfmt.IRIReference {
+	return &v
+}
+
+// IRIReferenceValue returns the value of the IRIReference pointer passed in or
+// the default value if the pointer is nil.
+func IRIReferenceValue(v *strfmt.IRIReference) strfmt.IRIReference {
+	if v == nil {
+		return strfmt.IRIReference("")
+	}
+
+	return *v
+}
@@ -0,0 +1,23 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestURIReferenceValue(t *testing.T) {
+	assert.Equal(t, strfmt.URIReference(""), URIReferenceValue(nil))
+	value := strfmt.URIReference("../foo")
+	assert.Equal(t, value, URIReferenceValue(&value))
+	assert.Equal(t, &value, URIReference(value))
+}
+
+func TestIRIReferenceValue(t *testing.T) {
+	assert.Equal(t, strfmt.IRIReference(""), IRIReferenceValue(nil))
+	value := strfmt.IRIReference("../föö")
+	assert.Equal(t, value, IRIReferenceValue(&value))
+	assert.Equal(t, &value, IRIReference(value))
+}
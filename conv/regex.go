@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// RegexPattern returns a pointer to of the RegexPattern value passed in.
+func RegexPattern(v strfmt.RegexPattern) *strfmt.RegexPattern {
+	return &v
+}
+
+// RegexPatternValue returns the value of the RegexPattern pointer passed in or
+// the default value if the pointer is nil.
+func RegexPatternValue(v *strfmt.RegexPattern) strfmt.RegexPattern {
+	if v == nil {
+		return strfmt.RegexPattern("")
+	}
+
+	return *v
+}
@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestRegexPatternValue(t *testing.T) {
+	assert.Equal(t, strfmt.RegexPattern(""), RegexPatternValue(nil))
+	value := strfmt.RegexPattern("^[a-z]+$")
+	assert.Equal(t, value, RegexPatternValue(&value))
+	assert.Equal(t, &value, RegexPattern(value))
+}
@@ -0,0 +1,171 @@
+package conv
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv/generic"
+)
+
+// UUIDSlice converts a slice of UUID pointers into a slice of UUID values.
+// nil pointers are converted to the default value.
+func UUIDSlice(vs []*strfmt.UUID) []strfmt.UUID {
+	return generic.Values(vs, "")
+}
+
+// UUIDPtrSlice converts a slice of UUID values into a slice of UUID pointers.
+func UUIDPtrSlice(vs []strfmt.UUID) []*strfmt.UUID {
+	return generic.Pointers(vs)
+}
+
+// UUID3Slice converts a slice of UUID3 pointers into a slice of UUID3 values.
+// nil pointers are converted to the default value.
+func UUID3Slice(vs []*strfmt.UUID3) []strfmt.UUID3 {
+	return generic.Values(vs, "")
+}
+
+// UUID3PtrSlice converts a slice of UUID3 values into a slice of UUID3 pointers.
+func UUID3PtrSlice(vs []strfmt.UUID3) []*strfmt.UUID3 {
+	return generic.Pointers(vs)
+}
+
+// UUID4Slice converts a slice of UUID4 pointers into a slice of UUID4 values.
+// nil pointers are converted to the default value.
+func UUID4Slice(vs []*strfmt.UUID4) []strfmt.UUID4 {
+	return generic.Values(vs, "")
+}
+
+// UUID4PtrSlice converts a slice of UUID4 values into a slice of UUID4 pointers.
+func UUID4PtrSlice(vs []strfmt.UUID4) []*strfmt.UUID4 {
+	return generic.Pointers(vs)
+}
+
+// UUID5Slice converts a slice of UUID5 pointers into a slice of UUID5 values.
+// nil pointers are converted to the default value.
+func UUID5Slice(vs []*strfmt.UUID5) []strfmt.UUID5 {
+	return generic.Values(vs, "")
+}
+
+// UUID5PtrSlice converts a slice of UUID5 values into a slice of UUID5 pointers.
+func UUID5PtrSlice(vs []strfmt.UUID5) []*strfmt.UUID5 {
+	return generic.Pointers(vs)
+}
+
+// UUID7Slice converts a slice of UUID7 pointers into a slice of UUID7 values.
+// nil pointers are converted to the default value.
+func UUID7Slice(vs []*strfmt.UUID7) []strfmt.UUID7 {
+	return generic.Values(vs, "")
+}
+
+// UUID7PtrSlice converts a slice of UUID7 values into a slice of UUID7 pointers.
+func UUID7PtrSlice(vs []strfmt.UUID7) []*strfmt.UUID7 {
+	return generic.Pointers(vs)
+}
+
+// ULIDSlice converts a slice of ULID pointers into a slice of ULID values.
+// nil pointers are converted to the default value.
+func ULIDSlice(vs []*strfmt.ULID) []strfmt.ULID {
+	return generic.Values(vs, strfmt.ULID{})
+}
+
+// ULIDPtrSlice converts a slice of ULID values into a slice of ULID pointers.
+func ULIDPtrSlice(vs []strfmt.ULID) []*strfmt.ULID {
+	return generic.Pointers(vs)
+}
+
+// DateTimeSlice converts a slice of DateTime pointers into a slice of DateTime values.
+// nil pointers are converted to the default value.
+func DateTimeSlice(vs []*strfmt.DateTime) []strfmt.DateTime {
+	return generic.Values(vs, strfmt.DateTime{})
+}
+
+// DateTimePtrSlice converts a slice of DateTime values into a slice of DateTime pointers.
+func DateTimePtrSlice(vs []strfmt.DateTime) []*strfmt.DateTime {
+	return generic.Pointers(vs)
+}
+
+// DateSlice converts a slice of Date pointers into a slice of Date values.
+// nil pointers are converted to the default value.
+func DateSlice(vs []*strfmt.Date) []strfmt.Date {
+	return generic.Values(vs, strfmt.Date{})
+}
+
+// DatePtrSlice converts a slice of Date values into a slice of Date pointers.
+func DatePtrSlice(vs []strfmt.Date) []*strfmt.Date {
+	return generic.Pointers(vs)
+}
+
+// DurationSlice converts a slice of Duration pointers into a slice of Duration values.
+// nil pointers are converted to the default value.
+func DurationSlice(vs []*strfmt.Duration) []strfmt.Duration {
+	return generic.Values(vs, 0)
+}
+
+// DurationPtrSlice converts a slice of Duration values into a slice of Duration pointers.
+func DurationPtrSlice(vs []strfmt.Duration) []*strfmt.Duration {
+	return generic.Pointers(vs)
+}
+
+// EmailSlice converts a slice of Email pointers into a slice of Email values.
+// nil pointers are converted to the default value.
+func EmailSlice(vs []*strfmt.Email) []strfmt.Email {
+	return generic.Values(vs, "")
+}
+
+// EmailPtrSlice converts a slice of Email values into a slice of Email pointers.
+func EmailPtrSlice(vs []strfmt.Email) []*strfmt.Email {
+	return generic.Pointers(vs)
+}
+
+// HostnameSlice converts a slice of Hostname pointers into a slice of Hostname values.
+// nil pointers are converted to the default value.
+func HostnameSlice(vs []*strfmt.Hostname) []strfmt.Hostname {
+	return generic.Values(vs, "")
+}
+
+// HostnamePtrSlice converts a slice of Hostname values into a slice of Hostname pointers.
+func HostnamePtrSlice(vs []strfmt.Hostname) []*strfmt.Hostname {
+	return generic.Pointers(vs)
+}
+
+// IPv4Slice converts a slice of IPv4 pointers into a slice of IPv4 values.
+// nil pointers are converted to the default value.
+func IPv4Slice(vs []*strfmt.IPv4) []strfmt.IPv4 {
+	return generic.Values(vs, "")
+}
+
+// IPv4PtrSlice converts a slice of IPv4 values into a slice of IPv4 pointers.
+func IPv4PtrSlice(vs []strfmt.IPv4) []*strfmt.IPv4 {
+	return generic.Pointers(vs)
+}
+
+// IPv6Slice converts a slice of IPv6 pointers into a slice of IPv6 values.
+// nil pointers are converted to the default value.
+func IPv6Slice(vs []*strfmt.IPv6) []strfmt.IPv6 {
+	return generic.Values(vs, "")
+}
+
+// IPv6PtrSlice converts a slice of IPv6 values into a slice of IPv6 pointers.
+func IPv6PtrSlice(vs []strfmt.IPv6) []*strfmt.IPv6 {
+	return generic.Pointers(vs)
+}
+
+// URISlice converts a slice of URI pointers into a slice of URI values.
+// nil pointers are converted to the default value.
+func URISlice(vs []*strfmt.URI) []strfmt.URI {
+	return generic.Values(vs, "")
+}
+
+// URIPtrSlice converts a slice of URI values into a slice of URI pointers.
+func URIPtrSlice(vs []strfmt.URI) []*strfmt.URI {
+	return generic.Pointers(vs)
+}
+
+// Base64Slice converts a slice of Base64 pointers into a slice of Base64 values.
+// nil pointers are converted to the default value.
+func Base64Slice(vs []*strfmt.Base64) []strfmt.Base64 {
+	return generic.Values(vs, nil)
+}
+
+// Base64PtrSlice converts a slice of Base64 values into a slice of Base64 pointers.
+func Base64PtrSlice(vs []strfmt.Base64) []*strfmt.Base64 {
+	return generic.Pointers(vs)
+}
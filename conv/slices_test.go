@@ -0,0 +1,220 @@
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestUUIDSlice(t *testing.T) {
+	value := strfmt.UUID("a8098c1a-f86e-11da-bd1a-00112444be1e")
+	values := UUIDSlice([]*strfmt.UUID{&value, nil})
+	assert.Equal(t, []strfmt.UUID{value, strfmt.UUID("")}, values)
+	assert.Nil(t, UUIDSlice(nil))
+
+	ptrs := UUIDPtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.UUID(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, UUIDPtrSlice(nil))
+}
+
+func TestUUID3Slice(t *testing.T) {
+	value := strfmt.UUID3("6fa459ea-ee8a-3ca4-894e-db77e160355e")
+	values := UUID3Slice([]*strfmt.UUID3{&value, nil})
+	assert.Equal(t, []strfmt.UUID3{value, strfmt.UUID3("")}, values)
+	assert.Nil(t, UUID3Slice(nil))
+
+	ptrs := UUID3PtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.UUID3(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, UUID3PtrSlice(nil))
+}
+
+func TestUUID4Slice(t *testing.T) {
+	value := strfmt.UUID4("a8098c1a-f86e-41da-bd1a-00112444be1e")
+	values := UUID4Slice([]*strfmt.UUID4{&value, nil})
+	assert.Equal(t, []strfmt.UUID4{value, strfmt.UUID4("")}, values)
+	assert.Nil(t, UUID4Slice(nil))
+
+	ptrs := UUID4PtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.UUID4(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, UUID4PtrSlice(nil))
+}
+
+func TestUUID5Slice(t *testing.T) {
+	value := strfmt.UUID5("886313e1-3b8a-5372-9b90-0c9aee199e5d")
+	values := UUID5Slice([]*strfmt.UUID5{&value, nil})
+	assert.Equal(t, []strfmt.UUID5{value, strfmt.UUID5("")}, values)
+	assert.Nil(t, UUID5Slice(nil))
+
+	ptrs := UUID5PtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.UUID5(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, UUID5PtrSlice(nil))
+}
+
+func TestUUID7Slice(t *testing.T) {
+	value := strfmt.UUID7("01920ba4-8d4a-7c2e-9f3b-5a1e2d3c4b5a")
+	values := UUID7Slice([]*strfmt.UUID7{&value, nil})
+	assert.Equal(t, []strfmt.UUID7{value, strfmt.UUID7("")}, values)
+	assert.Nil(t, UUID7Slice(nil))
+
+	ptrs := UUID7PtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.UUID7(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, UUID7PtrSlice(nil))
+}
+
+func TestULIDSlice(t *testing.T) {
+	value := strfmt.ULID{ULID: [16]byte{1, 2, 3}}
+	values := ULIDSlice([]*strfmt.ULID{&value, nil})
+	assert.Equal(t, []strfmt.ULID{value, strfmt.ULID{}}, values)
+	assert.Nil(t, ULIDSlice(nil))
+
+	ptrs := ULIDPtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.ULID{}, *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, ULIDPtrSlice(nil))
+}
+
+func TestDateTimeSlice(t *testing.T) {
+	value := strfmt.DateTime(time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC))
+	values := DateTimeSlice([]*strfmt.DateTime{&value, nil})
+	assert.Equal(t, []strfmt.DateTime{value, strfmt.DateTime{}}, values)
+	assert.Nil(t, DateTimeSlice(nil))
+
+	ptrs := DateTimePtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.DateTime{}, *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, DateTimePtrSlice(nil))
+}
+
+func TestDateSlice(t *testing.T) {
+	value := strfmt.Date(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	values := DateSlice([]*strfmt.Date{&value, nil})
+	assert.Equal(t, []strfmt.Date{value, strfmt.Date{}}, values)
+	assert.Nil(t, DateSlice(nil))
+
+	ptrs := DatePtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.Date{}, *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, DatePtrSlice(nil))
+}
+
+func TestDurationSlice(t *testing.T) {
+	value := strfmt.Duration(42 * time.Second)
+	values := DurationSlice([]*strfmt.Duration{&value, nil})
+	assert.Equal(t, []strfmt.Duration{value, strfmt.Duration(0)}, values)
+	assert.Nil(t, DurationSlice(nil))
+
+	ptrs := DurationPtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.Duration(0), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, DurationPtrSlice(nil))
+}
+
+func TestEmailSlice(t *testing.T) {
+	value := strfmt.Email("someone@example.com")
+	values := EmailSlice([]*strfmt.Email{&value, nil})
+	assert.Equal(t, []strfmt.Email{value, strfmt.Email("")}, values)
+	assert.Nil(t, EmailSlice(nil))
+
+	ptrs := EmailPtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.Email(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, EmailPtrSlice(nil))
+}
+
+func TestHostnameSlice(t *testing.T) {
+	value := strfmt.Hostname("example.com")
+	values := HostnameSlice([]*strfmt.Hostname{&value, nil})
+	assert.Equal(t, []strfmt.Hostname{value, strfmt.Hostname("")}, values)
+	assert.Nil(t, HostnameSlice(nil))
+
+	ptrs := HostnamePtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.Hostname(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, HostnamePtrSlice(nil))
+}
+
+func TestIPv4Slice(t *testing.T) {
+	value := strfmt.IPv4("192.168.0.1")
+	values := IPv4Slice([]*strfmt.IPv4{&value, nil})
+	assert.Equal(t, []strfmt.IPv4{value, strfmt.IPv4("")}, values)
+	assert.Nil(t, IPv4Slice(nil))
+
+	ptrs := IPv4PtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.IPv4(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, IPv4PtrSlice(nil))
+}
+
+func TestIPv6Slice(t *testing.T) {
+	value := strfmt.IPv6("::1")
+	values := IPv6Slice([]*strfmt.IPv6{&value, nil})
+	assert.Equal(t, []strfmt.IPv6{value, strfmt.IPv6("")}, values)
+	assert.Nil(t, IPv6Slice(nil))
+
+	ptrs := IPv6PtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.IPv6(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, IPv6PtrSlice(nil))
+}
+
+func TestURISlice(t *testing.T) {
+	value := strfmt.URI("http://example.com")
+	values := URISlice([]*strfmt.URI{&value, nil})
+	assert.Equal(t, []strfmt.URI{value, strfmt.URI("")}, values)
+	assert.Nil(t, URISlice(nil))
+
+	ptrs := URIPtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.URI(""), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, URIPtrSlice(nil))
+}
+
+func TestBase64Slice(t *testing.T) {
+	value := strfmt.Base64([]byte{4, 2})
+	values := Base64Slice([]*strfmt.Base64{&value, nil})
+	assert.Equal(t, []strfmt.Base64{value, strfmt.Base64(nil)}, values)
+	assert.Nil(t, Base64Slice(nil))
+
+	ptrs := Base64PtrSlice(values)
+	assert.Len(t, ptrs, 2)
+	assert.Equal(t, value, *ptrs[0])
+	assert.Equal(t, strfmt.Base64(nil), *ptrs[1])
+	assert.NotSame(t, &values[0], ptrs[0])
+	assert.Nil(t, Base64PtrSlice(nil))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// URITemplate returns a pointer to of the URITemplate value passed in.
+func URITemplate(v strfmt.URITemplate) *strfmt.URITemplate {
+	return &v
+}
+
+// URITemplateValue returns the value of the URITemplate pointer passed in or
+// the default value if the pointer is nil.
+func URITemplateValue(v *strfmt.URITemplate) strfmt.URITemplate {
+	if v == nil {
+		return strfmt.URITemplate("")
+	}
+
+	return *v
+}
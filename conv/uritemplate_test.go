@@ -0,0 +1,16 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/go-openapi/strfmt"
+)
+
+func TestURITemplateValue(t *testing.T) {
+	assert.Equal(t, strfmt.URITemplate(""), URITemplateValue(nil))
+	value := strfmt.URITemplate("/users/{id}")
+	assert.Equal(t, value, URITemplateValue(&value))
+	assert.Equal(t, &value, URITemplate(value))
+}
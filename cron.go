@@ -0,0 +1,368 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// cronSearchYears is how far ahead NextTime looks for a fire time
+const cronSearchYears = 5
+
+func init() {
+	c := CronExpression("")
+	// register this format in the default registry
+	addBuiltin("cron", &c, IsCronExpression)
+}
+
+// cronBounds describes the allowed values of a cron field
+type cronBounds struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSeconds     = cronBounds{name: "second", min: 0, max: 59}
+	cronMinutes     = cronBounds{name: "minute", min: 0, max: 59}
+	cronHours       = cronBounds{name: "hour", min: 0, max: 23}
+	cronDaysOfMonth = cronBounds{name: "day of month", min: 1, max: 31}
+	cronMonths      = cronBounds{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	cronDaysOfWeek = cronBounds{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// cronSchedule is a parsed cron expression: each field is a bit set of the matching values
+type cronSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are set when the day of month or day of week field starts with a "*":
+	// days must then match both fields, instead of any of them
+	domStar, dowStar bool
+}
+
+// parseCronExpression parses a 5 fields (minute hour day-of-month month day-of-week)
+// or 6 fields (second minute hour day-of-month month day-of-week) cron expression
+func parseCronExpression(str string) (*cronSchedule, error) {
+	fields := strings.Fields(str)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("expected 5 or 6 fields in cron expression, got %d: %q", len(fields), str)
+	}
+
+	var (
+		s   cronSchedule
+		err error
+	)
+	for i, target := range []struct {
+		bits   *uint64
+		bounds cronBounds
+	}{
+		{&s.second, cronSeconds},
+		{&s.minute, cronMinutes},
+		{&s.hour, cronHours},
+		{&s.dom, cronDaysOfMonth},
+		{&s.month, cronMonths},
+		{&s.dow, cronDaysOfWeek},
+	} {
+		if *target.bits, err = parseCronField(fields[i], target.bounds); err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", str, err)
+		}
+	}
+
+	// sunday may be written as 0 or 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = strings.HasPrefix(fields[3], "*")
+	s.dowStar = strings.HasPrefix(fields[5], "*")
+
+	return &s, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges ("1-5") and steps ("*/15", "0-30/10")
+func parseCronField(field string, bounds cronBounds) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field %q", stepPart, bounds.name, field)
+			}
+		}
+
+		var lo, hi int
+		if rangePart == "*" {
+			lo, hi = bounds.min, bounds.max
+		} else {
+			loPart, hiPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseCronValue(loPart, bounds); err != nil {
+				return 0, err
+			}
+			switch {
+			case isRange:
+				if hi, err = parseCronValue(hiPart, bounds); err != nil {
+					return 0, err
+				}
+				if hi < lo {
+					return 0, fmt.Errorf("invalid range %q in %s field", rangePart, bounds.name)
+				}
+			case hasStep:
+				hi = bounds.max
+			default:
+				hi = lo
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// parseCronValue parses a single numeric or named value of a cron field
+func parseCronValue(str string, bounds cronBounds) (int, error) {
+	if v, ok := bounds.names[strings.ToUpper(str)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(str)
+	if err != nil || str[0] == '+' || str[0] == '-' {
+		return 0, fmt.Errorf("invalid %s %q", bounds.name, str)
+	}
+	if v < bounds.min || v > bounds.max {
+		return 0, fmt.Errorf("%s %d out of range [%d-%d]", bounds.name, v, bounds.min, bounds.max)
+	}
+	return v, nil
+}
+
+// dayMatches tells whether the day of t is scheduled, following the conventions of Vixie cron:
+// when both day fields are restricted, a day matching any of them is scheduled
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the first scheduled time strictly after from, in the location of from
+func (s *cronSchedule) next(from time.Time) (time.Time, bool) {
+	loc := from.Location()
+	t := from.Add(time.Second - time.Duration(from.Nanosecond()))
+	limit := t.Year() + cronSearchYears
+
+	// truncated is set once the smaller units of t have been reset
+	truncated := false
+
+wrap:
+	if t.Year() > limit {
+		return time.Time{}, false
+	}
+
+	for s.month&(1<<uint(t.Month())) == 0 {
+		if !truncated {
+			truncated = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto wrap
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !truncated {
+			truncated = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 0, 1)
+		if t.Day() == 1 {
+			goto wrap
+		}
+	}
+
+	for s.hour&(1<<uint(t.Hour())) == 0 {
+		if !truncated {
+			truncated = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto wrap
+		}
+	}
+
+	for s.minute&(1<<uint(t.Minute())) == 0 {
+		if !truncated {
+			truncated = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto wrap
+		}
+	}
+
+	for s.second&(1<<uint(t.Second())) == 0 {
+		if !truncated {
+			truncated = true
+			t = t.Truncate(time.Second)
+		}
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto wrap
+		}
+	}
+
+	return t, true
+}
+
+// IsCronExpression returns true when the string is a valid cron expression.
+//
+// Both the 5 fields Unix format (minute hour day-of-month month day-of-week) and the 6 fields format,
+// with seconds first, are accepted. Fields are lists of values, ranges (e.g. "1-5") and steps (e.g. "*/15").
+// Months may be named (JAN-DEC) and days of week as well (SUN-SAT). Sunday is either 0 or 7.
+func IsCronExpression(str string) bool {
+	_, err := parseCronExpression(str)
+	return err == nil
+}
+
+// CronExpression represents a cron schedule (e.g. "*/15 9-17 * * MON-FRI")
+//
+// swagger:strfmt cron
+type CronExpression string
+
+// NextTime returns the first time strictly after from when this cron expression fires,
+// in the location of from.
+//
+// An error is returned when the expression is invalid or never fires in the next 5 years (e.g. "0 0 30 2 *").
+func (c CronExpression) NextTime(from time.Time) (time.Time, error) {
+	s, err := parseCronExpression(string(c))
+	if err != nil {
+		return time.Time{}, err
+	}
+	next, ok := s.next(from)
+	if !ok {
+		return time.Time{}, fmt.Errorf("cron expression %q never fires after %v", string(c), from)
+	}
+	return next, nil
+}
+
+// MarshalText turns this instance into text
+func (c CronExpression) MarshalText() ([]byte, error) {
+	return []byte(string(c)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (c *CronExpression) UnmarshalText(data []byte) error { // validation is performed later on
+	*c = CronExpression(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (c *CronExpression) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*c = CronExpression(string(v))
+	case string:
+		*c = CronExpression(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.CronExpression from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (c CronExpression) Value() (driver.Value, error) {
+	return driver.Value(string(c)), nil
+}
+
+func (c CronExpression) String() string {
+	return string(c)
+}
+
+// MarshalJSON returns the CronExpression as JSON
+func (c CronExpression) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+// UnmarshalJSON sets the CronExpression from JSON
+func (c *CronExpression) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var cstr string
+	if err := json.Unmarshal(data, &cstr); err != nil {
+		return err
+	}
+	*c = CronExpression(cstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (c CronExpression) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": c.String()})
+}
+
+// UnmarshalBSON document into this value
+func (c *CronExpression) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(string); ok {
+		*c = CronExpression(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as CronExpression")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (c *CronExpression) DeepCopyInto(out *CronExpression) {
+	*out = *c
+}
+
+// DeepCopy copies the receiver into a new CronExpression.
+func (c *CronExpression) DeepCopy() *CronExpression {
+	if c == nil {
+		return nil
+	}
+	out := new(CronExpression)
+	c.DeepCopyInto(out)
+	return out
+}
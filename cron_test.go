@@ -0,0 +1,139 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatCronExpression(t *testing.T) {
+	c := CronExpression("* * * * *")
+	str := "*/15 9-17 * * MON-FRI"
+	validCrons := []string{
+		"* * * * *",
+		"0 0 * * *",
+		"59 23 31 12 7",
+		"0 0 1 JAN SUN",
+		"0 0 1 jan-jun mon,wed,fri",
+		"*/5 * * * *",
+		"0-30/10 * * * *",
+		"5/15 * * * *",
+		"0 9,12,18 * * 1-5",
+		"30 * * * * *",
+		"*/10 0 12 * * SAT",
+		"  0   0  *  *  *  ",
+	}
+	invalidCrons := []string{
+		"",
+		"*",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 0 *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * FOO *",
+		"* * * * MON-FOO",
+		"* * * JAN *-1",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"*/-1 * * * *",
+		"1,,2 * * * *",
+		"-1 * * * *",
+		"+1 * * * *",
+		"60 * * * * *",
+		"@daily",
+	}
+
+	testStringFormat(t, &c, "cron", str, validCrons, invalidCrons)
+}
+
+func TestCronExpression_NextTime(t *testing.T) {
+	from := time.Date(2024, time.January, 15, 10, 30, 20, 500, time.UTC) // a monday
+
+	for _, tc := range []struct {
+		cron     CronExpression
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.January, 15, 10, 31, 0, 0, time.UTC)},
+		{"* * * * * *", time.Date(2024, time.January, 15, 10, 30, 21, 0, time.UTC)},
+		{"30 * * * *", time.Date(2024, time.January, 15, 11, 30, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.January, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2024, time.January, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 JAN *", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * SAT", time.Date(2024, time.January, 20, 12, 0, 0, 0, time.UTC)},
+		{"0 12 * * 0", time.Date(2024, time.January, 21, 12, 0, 0, 0, time.UTC)},
+		{"0 12 * * 7", time.Date(2024, time.January, 21, 12, 0, 0, 0, time.UTC)},
+		{"45 10-11 * * MON-FRI", time.Date(2024, time.January, 15, 10, 45, 0, 0, time.UTC)},
+		{"15,45 */6 * * *", time.Date(2024, time.January, 15, 12, 15, 0, 0, time.UTC)},
+		// day of month and day of week both restricted: any of them matches
+		{"0 0 20 * FRI", time.Date(2024, time.January, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 16 * SUN", time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		// day of week restricted only: both must match
+		{"0 0 * 3 MON", time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 30 10 * * *", time.Date(2024, time.January, 16, 10, 30, 0, 0, time.UTC)},
+	} {
+		next, err := tc.cron.NextTime(from)
+		require.NoErrorf(t, err, "unexpected error for %q", tc.cron)
+		assert.Truef(t, tc.expected.Equal(next), "unexpected next time for %q: expected %v, got %v", tc.cron, tc.expected, next)
+	}
+
+	t.Run("keeps the location of the reference time", func(t *testing.T) {
+		loc := time.FixedZone("UTC+2", 2*3600)
+		next, err := CronExpression("0 9 * * *").NextTime(time.Date(2024, time.January, 15, 10, 0, 0, 0, loc))
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, time.January, 16, 9, 0, 0, 0, loc), next)
+	})
+
+	t.Run("is strictly after the reference time", func(t *testing.T) {
+		at := time.Date(2024, time.January, 15, 9, 0, 0, 0, time.UTC)
+		next, err := CronExpression("0 9 * * *").NextTime(at)
+		require.NoError(t, err)
+		assert.Equal(t, at.AddDate(0, 0, 1), next)
+	})
+
+	t.Run("errors on invalid or impossible expressions", func(t *testing.T) {
+		_, err := CronExpression("* * *").NextTime(from)
+		require.Error(t, err)
+		_, err = CronExpression("0 0 30 2 *").NextTime(from)
+		require.Error(t, err)
+	})
+}
+
+func TestDeepCopyCronExpression(t *testing.T) {
+	c := CronExpression("0 0 * * *")
+	in := &c
+
+	out := new(CronExpression)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *CronExpression
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
@@ -0,0 +1,211 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	dataURLScheme      = "data:"
+	dataURLBase64      = ";base64"
+	dataURLDefaultMime = "text/plain"
+)
+
+func init() {
+	du := DataURL("")
+	// register this format in the default registry
+	addBuiltin("data-url", &du, IsDataURL)
+}
+
+// parseDataURL splits a data URL into its media type, base64 marker and raw (still encoded) payload.
+func parseDataURL(str string) (mediaType string, isBase64 bool, payload string, err error) {
+	if len(str) < len(dataURLScheme) || !strings.EqualFold(str[:len(dataURLScheme)], dataURLScheme) {
+		return "", false, "", errors.New("a data URL must start with \"data:\"")
+	}
+
+	header, payload, found := strings.Cut(str[len(dataURLScheme):], ",")
+	if !found {
+		return "", false, "", errors.New("a data URL must have a comma separating the header from the data")
+	}
+
+	if len(header) >= len(dataURLBase64) && strings.EqualFold(header[len(header)-len(dataURLBase64):], dataURLBase64) {
+		isBase64 = true
+		header = header[:len(header)-len(dataURLBase64)]
+	}
+
+	if header == "" || header[0] == ';' {
+		header = dataURLDefaultMime + header
+	}
+	mediaType, _, err = mime.ParseMediaType(header)
+	if err != nil {
+		return "", false, "", err
+	}
+	if !strings.Contains(mediaType, "/") {
+		return "", false, "", fmt.Errorf("invalid media type in data URL: %q", mediaType)
+	}
+
+	return mediaType, isBase64, payload, nil
+}
+
+// decodeDataURL returns the decoded payload of a data URL
+func decodeDataURL(isBase64 bool, payload string) ([]byte, error) {
+	unescaped, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, err
+	}
+	if !isBase64 {
+		return []byte(unescaped), nil
+	}
+	return base64.StdEncoding.DecodeString(unescaped)
+}
+
+// IsDataURL returns true when the string is a valid data URL, as specified by RFC 2397.
+//
+// A data URL has the form "data:[<mediatype>][;base64],<data>".
+func IsDataURL(str string) bool {
+	_, isBase64, payload, err := parseDataURL(str)
+	if err != nil {
+		return false
+	}
+	_, err = decodeDataURL(isBase64, payload)
+	return err == nil
+}
+
+// DataURL represents a "data:" URL, as specified by RFC 2397
+//
+// swagger:strfmt data-url
+type DataURL string
+
+// MimeType returns the media type of the data, without its parameters.
+//
+// It defaults to "text/plain" when no media type is specified, and is empty when the data URL is invalid.
+func (d DataURL) MimeType() string {
+	mediaType, _, _, err := parseDataURL(string(d))
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// IsBase64 returns true when the data is base64-encoded
+func (d DataURL) IsBase64() bool {
+	_, isBase64, _, err := parseDataURL(string(d))
+	return err == nil && isBase64
+}
+
+// Data returns the decoded payload
+func (d DataURL) Data() ([]byte, error) {
+	_, isBase64, payload, err := parseDataURL(string(d))
+	if err != nil {
+		return nil, err
+	}
+	return decodeDataURL(isBase64, payload)
+}
+
+// MarshalText turns this instance into text
+func (d DataURL) MarshalText() ([]byte, error) {
+	return []byte(string(d)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (d *DataURL) UnmarshalText(data []byte) error { // validation is performed later on
+	*d = DataURL(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (d *DataURL) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*d = DataURL(string(v))
+	case string:
+		*d = DataURL(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.DataURL from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (d DataURL) Value() (driver.Value, error) {
+	return driver.Value(string(d)), nil
+}
+
+func (d DataURL) String() string {
+	return string(d)
+}
+
+// MarshalJSON returns the DataURL as JSON
+func (d DataURL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(d))
+}
+
+// UnmarshalJSON sets the DataURL from JSON
+func (d *DataURL) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var dstr string
+	if err := json.Unmarshal(data, &dstr); err != nil {
+		return err
+	}
+	*d = DataURL(dstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (d DataURL) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": d.String()})
+}
+
+// UnmarshalBSON document into this value
+func (d *DataURL) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*d = DataURL(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as DataURL")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (d *DataURL) DeepCopyInto(out *DataURL) {
+	*out = *d
+}
+
+// DeepCopy copies the receiver into a new DataURL.
+func (d *DataURL) DeepCopy() *DataURL {
+	if d == nil {
+		return nil
+	}
+	out := new(DataURL)
+	d.DeepCopyInto(out)
+	return out
+}
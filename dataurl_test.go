@@ -0,0 +1,118 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatDataURL(t *testing.T) {
+	dataURL := DataURL("data:,Hello")
+	str := "data:text/plain;base64,SGVsbG8="
+	validDataURLs := []string{
+		"data:,",
+		"data:,abc",
+		"data:,Hello%2C%20World%21",
+		"data:;base64,SGVsbG8=",
+		"data:text/plain,Hello",
+		"data:text/plain;charset=utf-8,Hello",
+		"data:text/plain;charset=utf-8;base64,SGVsbG8=",
+		"data:image/png;base64,iVBORw0KGgo=",
+		"DATA:TEXT/PLAIN;BASE64,SGVsbG8=",
+		"data:;charset=utf-8,Hello",
+	}
+	invalidDataURLs := []string{
+		"",
+		"data:",
+		"data:text/plain",
+		"http://example.com",
+		"text/plain,Hello",
+		"data:text,Hello",
+		"data:text/plain;charset,Hello",
+		"data:base64,SGVsbG8=",
+		"data:;base64,SGVsbG8",
+		"data:;base64,!!!!",
+		"data:,100%",
+	}
+
+	testStringFormat(t, &dataURL, "data-url", str, validDataURLs, invalidDataURLs)
+}
+
+func TestDataURL_Components(t *testing.T) {
+	for _, tc := range []struct {
+		dataURL  DataURL
+		mimeType string
+		isBase64 bool
+		data     string
+	}{
+		{"data:text/plain;base64,SGVsbG8=", "text/plain", true, "Hello"},
+		{"data:,Hello", "text/plain", false, "Hello"},
+		{"data:,abc", "text/plain", false, "abc"},
+		{"data:,", "text/plain", false, ""},
+		{"data:,Hello%2C%20World%21", "text/plain", false, "Hello, World!"},
+		{"data:Image/PNG;base64,AAEC", "image/png", true, "\x00\x01\x02"},
+		{"data:text/html;charset=utf-8,%3Ch1%3E", "text/html", false, "<h1>"},
+	} {
+		assert.Equalf(t, tc.mimeType, tc.dataURL.MimeType(), "unexpected mime type for %q", tc.dataURL)
+		assert.Equalf(t, tc.isBase64, tc.dataURL.IsBase64(), "unexpected base64 marker for %q", tc.dataURL)
+		data, err := tc.dataURL.Data()
+		require.NoErrorf(t, err, "unexpected error decoding %q", tc.dataURL)
+		assert.Equalf(t, []byte(tc.data), data, "unexpected data for %q", tc.dataURL)
+	}
+
+	invalid := DataURL("text/plain,Hello")
+	assert.Empty(t, invalid.MimeType())
+	assert.False(t, invalid.IsBase64())
+	_, err := invalid.Data()
+	require.Error(t, err)
+
+	_, err = DataURL("data:;base64,!!!!").Data()
+	require.Error(t, err)
+}
+
+func TestDataURL_LargePayload(t *testing.T) {
+	payload := bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 1<<18) // 1 MiB
+	dataURL := DataURL("data:application/octet-stream;base64," + base64.StdEncoding.EncodeToString(payload))
+
+	assert.True(t, IsDataURL(dataURL.String()))
+	assert.Equal(t, "application/octet-stream", dataURL.MimeType())
+	data, err := dataURL.Data()
+	require.NoError(t, err)
+	assert.Equal(t, payload, data)
+
+	// a single corrupted byte at the end of a large payload invalidates the whole URL
+	assert.False(t, IsDataURL(dataURL.String()[:len(dataURL)-1]+"!"))
+}
+
+func TestDeepCopyDataURL(t *testing.T) {
+	dataURL := DataURL("data:,Hello")
+	in := &dataURL
+
+	out := new(DataURL)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *DataURL
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
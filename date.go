@@ -19,15 +19,36 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	bsonprim "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// NormalizeDateForMarshal provides a normalization function on dates before marshalling (e.g. time.Time.UTC).
+//
+// By default, the date is not changed: it is rendered as the calendar day of its own location,
+// e.g. a date parsed in DefaultTimeLocation is rendered as it was parsed.
+var NormalizeDateForMarshal = func(t time.Time) time.Time { return t }
+
+// DateZero sets the sentinel date representing "no date set", checked by Date.IsDateZero.
+//
+// It defaults to the unix epoch, 1970-01-01 UTC, like UnixZero for DateTime.
+// Zero valued dates (i.e. time.Time{}) are checked by Date.IsZero.
+var DateZero = time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// DateMarshalZeroAsNull makes Date.MarshalJSON render zero valued dates as JSON null.
+//
+// It defaults to false: zero valued dates are rendered as "0001-01-01".
+// Use Date.MarshalJSONOrNull to render null regardless of this setting.
+var DateMarshalZeroAsNull = false
+
 func init() {
 	d := Date{}
 	// register this format in the default registry
-	Default.Add("date", &d, IsDate)
+	addBuiltin("date", &d, IsDate)
 }
 
 // IsDate returns true when the string is a valid date
@@ -44,12 +65,31 @@ const (
 
 // Date represents a date from the API
 //
+// Dates carry no time zone: unless told otherwise (see ParseDateInLocation),
+// a date is parsed as midnight in DefaultTimeLocation, which is UTC by default.
+//
 // swagger:strfmt date
 type Date time.Time
 
 // String converts this date into a string
 func (d Date) String() string {
-	return time.Time(d).Format(RFC3339FullDate)
+	return NormalizeDateForMarshal(time.Time(d)).Format(RFC3339FullDate)
+}
+
+// IsZero returns whether the date is a zero value
+func (d *Date) IsZero() bool {
+	if d == nil {
+		return true
+	}
+	return time.Time(*d).IsZero()
+}
+
+// IsDateZero returns whether the date is equivalent to the DateZero sentinel
+func (d *Date) IsDateZero() bool {
+	if d == nil {
+		return true
+	}
+	return time.Time(*d).Equal(DateZero)
 }
 
 // UnmarshalText parses a text representation into a date type
@@ -65,12 +105,57 @@ func (d *Date) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// ParseDate parses a RFC 3339 full-date string (e.g. "2024-01-15").
+//
+// Like Date.UnmarshalText, the date is anchored at midnight in DefaultTimeLocation (UTC by default).
+// Unlike Date.UnmarshalText, an empty string is an error.
+func ParseDate(s string) (Date, error) {
+	return ParseDateInLocation(s, DefaultTimeLocation)
+}
+
+// ParseDateInLocation parses a RFC 3339 full-date string (e.g. "2024-01-15"),
+// anchored at midnight in the given location. A nil location stands for UTC.
+//
+// The date is rendered as the same calendar day, unless NormalizeDateForMarshal moves it to another location.
+func ParseDateInLocation(s string, loc *time.Location) (Date, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	dd, err := time.ParseInLocation(RFC3339FullDate, s, loc)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date(dd), nil
+}
+
+// InLocation returns the same calendar day as this date, anchored at midnight in the given location.
+// A nil location stands for UTC.
+func (d Date) InLocation(loc *time.Location) Date {
+	if loc == nil {
+		loc = time.UTC
+	}
+	year, month, day := time.Time(d).Date()
+	return Date(time.Date(year, month, day, 0, 0, 0, 0, loc))
+}
+
 // MarshalText serializes this date type to string
 func (d Date) MarshalText() ([]byte, error) {
 	return []byte(d.String()), nil
 }
 
+// maxScannedDays bounds the day counts scanned as float64, to avoid overflows
+const maxScannedDays = 1 << 31
+
+// dateFromUnix returns the date of a unix timestamp in seconds, at midnight UTC
+func dateFromUnix(sec int64) Date {
+	return Date(time.Unix(sec, 0).UTC().Truncate(24 * time.Hour))
+}
+
 // Scan scans a Date value from database driver type.
+//
+// Besides strings and time.Time values, numbers are accepted as follows:
+//   - int64 and int32 values are unix timestamps in seconds, truncated to midnight UTC (e.g. 86400 is 1970-01-02)
+//   - float64 values are day counts since the unix epoch, with any fraction of a day ignored (e.g. 1.5 is 1970-01-02)
 func (d *Date) Scan(raw interface{}) error {
 	switch v := raw.(type) {
 	case []byte:
@@ -80,6 +165,18 @@ func (d *Date) Scan(raw interface{}) error {
 	case time.Time:
 		*d = Date(v)
 		return nil
+	case int64:
+		*d = dateFromUnix(v)
+		return nil
+	case int32:
+		*d = dateFromUnix(int64(v))
+		return nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) > maxScannedDays {
+			return fmt.Errorf("cannot sql.Scan() strfmt.Date from: %#v", v)
+		}
+		*d = Date(time.Unix(0, 0).UTC().AddDate(0, 0, int(math.Floor(v))))
+		return nil
 	case nil:
 		*d = Date{}
 		return nil
@@ -93,9 +190,22 @@ func (d Date) Value() (driver.Value, error) {
 	return driver.Value(d.String()), nil
 }
 
-// MarshalJSON returns the Date as JSON
+// MarshalJSON returns the Date as JSON.
+//
+// Zero valued dates are rendered as null when DateMarshalZeroAsNull is set.
 func (d Date) MarshalJSON() ([]byte, error) {
-	return json.Marshal(time.Time(d).Format(RFC3339FullDate))
+	if DateMarshalZeroAsNull && d.IsZero() {
+		return []byte(jsonNull), nil
+	}
+	return json.Marshal(NormalizeDateForMarshal(time.Time(d)).Format(RFC3339FullDate))
+}
+
+// MarshalJSONOrNull returns the Date as JSON, or null if the date is a zero value
+func (d Date) MarshalJSONOrNull() ([]byte, error) {
+	if d.IsZero() {
+		return []byte(jsonNull), nil
+	}
+	return json.Marshal(NormalizeDateForMarshal(time.Time(d)).Format(RFC3339FullDate))
 }
 
 // UnmarshalJSON sets the Date from JSON
@@ -137,6 +247,39 @@ func (d *Date) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes value as Date")
 }
 
+// MarshalBSONValue marshals this Date as a BSON DateTime, at midnight UTC of the day rendered by String
+func (d Date) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	year, month, day := NormalizeDateForMarshal(time.Time(d)).Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return bson.MarshalValue(bsonprim.NewDateTimeFromTime(midnight))
+}
+
+// UnmarshalBSONValue reads this Date from a BSON DateTime.
+//
+// The time of day is truncated: the date is the calendar day of the BSON DateTime in UTC.
+// BSON strings and documents such as {"data": "2006-01-02"}, as rendered by MarshalBSON, are accepted as well.
+func (d *Date) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	raw := bson.RawValue{Type: tpe, Value: data}
+	if tpe == bson.TypeDateTime {
+		if dt, ok := raw.DateTimeOK(); ok {
+			year, month, day := time.UnixMilli(dt).UTC().Date()
+			*d = Date(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+			return nil
+		}
+		return fmt.Errorf("couldn't unmarshal bson value of type %s as Date", tpe)
+	}
+
+	str, err := unmarshalBSONString(tpe, data, "Date")
+	if err != nil {
+		return err
+	}
+	if str == "" {
+		*d = Date{}
+		return nil
+	}
+	return d.UnmarshalText([]byte(str))
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (d *Date) DeepCopyInto(out *Date) {
 	*out = *d
@@ -185,3 +328,66 @@ func (d *Date) UnmarshalBinary(data []byte) error {
 func (d Date) Equal(d2 Date) bool {
 	return time.Time(d).Equal(time.Time(d2))
 }
+
+// IsLeapYear returns true when the year of this date is a leap year of the Gregorian calendar
+func (d Date) IsLeapYear() bool {
+	year := time.Time(d).Year()
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// DaysInMonth returns the number of days in the month of this date, from 28 to 31
+func (d Date) DaysInMonth() int {
+	switch time.Time(d).Month() {
+	case time.February:
+		if d.IsLeapYear() {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
+	}
+}
+
+// DayOfYear returns the day of the year of this date, from 1 to 366
+func (d Date) DayOfYear() int {
+	return time.Time(d).YearDay()
+}
+
+// DaysRemaining returns the number of days from this date to December 31 of the same year,
+// e.g. 0 on December 31
+func (d Date) DaysRemaining() int {
+	daysInYear := 365
+	if d.IsLeapYear() {
+		daysInYear = 366
+	}
+	return daysInYear - d.DayOfYear()
+}
+
+// TruncateToMonth returns the first day of the month of this date, at midnight in the same location
+func (d Date) TruncateToMonth() Date {
+	t := time.Time(d)
+	return Date(time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()))
+}
+
+// Format returns this date formatted with a layout of the time package, e.g. "January 2, 2006".
+//
+// Unlike String, the date is formatted in its own location.
+func (d Date) Format(layout string) string {
+	return time.Time(d).Format(layout)
+}
+
+// AppendFormat is like Format but appends the formatted date to b
+func (d Date) AppendFormat(b []byte, layout string) []byte {
+	return time.Time(d).AppendFormat(b, layout)
+}
+
+// FormatLocale returns this date formatted with a layout of the time package, as seen in the given location.
+// A nil location stands for UTC.
+func (d Date) FormatLocale(layout string, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Time(d).In(loc).Format(layout)
+}
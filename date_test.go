@@ -19,6 +19,8 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/gob"
+	"encoding/json"
+	"math"
 	"testing"
 	"time"
 
@@ -93,6 +95,35 @@ func TestDate_Scan(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestDate_ScanNumbers(t *testing.T) {
+	for _, tc := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{int64(0), "1970-01-01"},
+		{int64(86399), "1970-01-01"},
+		{int64(86400), "1970-01-02"},
+		{int64(1709208794), "2024-02-29"},
+		{int64(-1), "1969-12-31"},
+		{int32(86400), "1970-01-02"},
+		{int32(1709208794), "2024-02-29"},
+		{float64(0), "1970-01-01"},
+		{float64(1.5), "1970-01-02"},
+		{float64(19782), "2024-02-29"},
+		{float64(-0.5), "1969-12-31"},
+	} {
+		var d Date
+		require.NoErrorf(t, d.Scan(tc.value), "unexpected error for %#v", tc.value)
+		assert.Equalf(t, tc.expected, d.String(), "unexpected date for %#v", tc.value)
+		assert.Equalf(t, time.UTC, time.Time(d).Location(), "unexpected location for %#v", tc.value)
+	}
+
+	var d Date
+	for _, invalid := range []interface{}{math.NaN(), math.Inf(1), math.Inf(-1), float64(1 << 40)} {
+		require.Errorf(t, d.Scan(invalid), "expected an error for %#v", invalid)
+	}
+}
+
 func TestDate_Value(t *testing.T) {
 	ref := time.Now().Truncate(24 * time.Hour).UTC()
 	date := Date(ref)
@@ -162,6 +193,126 @@ func TestGobEncodingDate(t *testing.T) {
 	assert.Equal(t, now.Day(), time.Time(result).Day())
 }
 
+func TestGobEncodingDate_Nanoseconds(t *testing.T) {
+	in := Date(time.Date(2024, time.February, 29, 12, 13, 14, 123456789, time.UTC))
+
+	b := bytes.Buffer{}
+	require.NoError(t, gob.NewEncoder(&b).Encode(in))
+
+	var result Date
+	require.NoError(t, gob.NewDecoder(&b).Decode(&result))
+	assert.Equal(t, in, result)
+	assert.Equal(t, 123456789, time.Time(result).Nanosecond())
+}
+
+func TestDate_MarshalJSON_Override(t *testing.T) {
+	// 23:30 on the 1st of March in UTC-2 is already the 2nd of March in UTC
+	d := Date(time.Date(2024, time.March, 1, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*3600)))
+
+	bb, err := d.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-03-01"`, string(bb))
+
+	t.Run("with a UTC normalization", func(t *testing.T) {
+		oldNormalizeMarshal := NormalizeDateForMarshal
+		t.Cleanup(func() {
+			NormalizeDateForMarshal = oldNormalizeMarshal
+		})
+
+		NormalizeDateForMarshal = time.Time.UTC
+
+		bb, err := d.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-03-02"`, string(bb))
+		assert.Equal(t, "2024-03-02", d.String())
+	})
+
+	t.Run("with a fixed zone normalization", func(t *testing.T) {
+		oldNormalizeMarshal := NormalizeDateForMarshal
+		t.Cleanup(func() {
+			NormalizeDateForMarshal = oldNormalizeMarshal
+		})
+
+		NormalizeDateForMarshal = func(t time.Time) time.Time {
+			return t.In(time.FixedZone("UTC-5", -5*3600))
+		}
+
+		bb, err := d.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, `"2024-03-01"`, string(bb))
+
+		txt, err := d.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-01", string(txt))
+	})
+
+	bb, err = d.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-03-01"`, string(bb), "the default normalization should be restored")
+	assert.Equal(t, "2024-03-01", d.String())
+}
+
+func TestDate_DefaultTimeLocation(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	oldLocation := DefaultTimeLocation
+	t.Cleanup(func() {
+		DefaultTimeLocation = oldLocation
+	})
+	DefaultTimeLocation = paris
+
+	var d Date
+	require.NoError(t, d.UnmarshalText([]byte("2024-01-15")))
+	assert.Equal(t, "2024-01-15", d.String())
+
+	bb, err := d.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-01-15"`, string(bb))
+
+	var decoded Date
+	require.NoError(t, json.Unmarshal(bb, &decoded))
+	assert.Equal(t, d, decoded)
+
+	parsed, err := ParseDate("2024-01-15")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-15", parsed.String())
+
+	inParis, err := ParseDateInLocation("2024-01-15", paris)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-15", inParis.String())
+}
+
+func TestDate_IsZero(t *testing.T) {
+	var nilDate *Date
+	assert.True(t, nilDate.IsZero())
+	assert.True(t, nilDate.IsDateZero())
+
+	zero := Date{}
+	assert.True(t, zero.IsZero())
+	assert.False(t, zero.IsDateZero())
+
+	var epoch Date
+	require.NoError(t, epoch.UnmarshalText([]byte("1970-01-01")))
+	assert.False(t, epoch.IsZero())
+	assert.True(t, epoch.IsDateZero())
+
+	d := Date(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+	assert.False(t, d.IsZero())
+	assert.False(t, d.IsDateZero())
+
+	t.Run("with a custom sentinel", func(t *testing.T) {
+		oldDateZero := DateZero
+		t.Cleanup(func() {
+			DateZero = oldDateZero
+		})
+
+		DateZero = time.Time(d)
+		assert.True(t, d.IsDateZero())
+		assert.False(t, epoch.IsDateZero())
+	})
+}
+
 func TestDate_Equal(t *testing.T) {
 	t.Parallel()
 
@@ -174,3 +325,176 @@ func TestDate_Equal(t *testing.T) {
 	assert.True(t, d1.Equal(d2), "Date instances should be equal")
 	assert.False(t, d1.Equal(d3), "Date instances should not be equal")
 }
+
+func TestDate_Calendar(t *testing.T) {
+	for _, tc := range []struct {
+		date          string
+		leap          bool
+		daysInMonth   int
+		dayOfYear     int
+		daysRemaining int
+		month         string
+	}{
+		{date: "2000-02-10", leap: true, daysInMonth: 29, dayOfYear: 41, daysRemaining: 325, month: "2000-02-01"},
+		{date: "1900-02-10", leap: false, daysInMonth: 28, dayOfYear: 41, daysRemaining: 324, month: "1900-02-01"},
+		{date: "2100-02-28", leap: false, daysInMonth: 28, dayOfYear: 59, daysRemaining: 306, month: "2100-02-01"},
+		{date: "2024-02-29", leap: true, daysInMonth: 29, dayOfYear: 60, daysRemaining: 306, month: "2024-02-01"},
+		{date: "2024-12-31", leap: true, daysInMonth: 31, dayOfYear: 366, daysRemaining: 0, month: "2024-12-01"},
+		{date: "2023-12-31", leap: false, daysInMonth: 31, dayOfYear: 365, daysRemaining: 0, month: "2023-12-01"},
+		{date: "2023-01-01", leap: false, daysInMonth: 31, dayOfYear: 1, daysRemaining: 364, month: "2023-01-01"},
+		{date: "2023-04-15", leap: false, daysInMonth: 30, dayOfYear: 105, daysRemaining: 260, month: "2023-04-01"},
+		{date: "2023-11-30", leap: false, daysInMonth: 30, dayOfYear: 334, daysRemaining: 31, month: "2023-11-01"},
+	} {
+		t.Run(tc.date, func(t *testing.T) {
+			d := testDate(t, tc.date)
+			assert.Equal(t, tc.leap, d.IsLeapYear())
+			assert.Equal(t, tc.daysInMonth, d.DaysInMonth())
+			assert.Equal(t, tc.dayOfYear, d.DayOfYear())
+			assert.Equal(t, tc.daysRemaining, d.DaysRemaining())
+			assert.Equal(t, tc.month, d.TruncateToMonth().String())
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("2024-01-15")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC), time.Time(d))
+
+	_, err = ParseDate("")
+	require.Error(t, err)
+	_, err = ParseDate("2024-01-15T10:00:00Z")
+	require.Error(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	d, err = ParseDateInLocation("2024-01-15", newYork)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.January, 15, 5, 0, 0, 0, time.UTC), time.Time(d).UTC())
+	assert.Equal(t, newYork, time.Time(d).Location())
+	assert.Equal(t, "2024-01-15", d.String())
+
+	d, err = ParseDateInLocation("2024-01-15", nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, time.Time(d).Location())
+
+	_, err = ParseDateInLocation("2024-02-30", newYork)
+	require.Error(t, err)
+}
+
+func TestDate_InLocation(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	d := testDate(t, "2024-01-15")
+	inTokyo := d.InLocation(tokyo)
+	assert.Equal(t, time.Date(2024, time.January, 15, 0, 0, 0, 0, tokyo), time.Time(inTokyo))
+	assert.Equal(t, tokyo, time.Time(inTokyo).Location())
+
+	// the calendar day is kept when converting back
+	assert.Equal(t, d, inTokyo.InLocation(time.UTC))
+	assert.Equal(t, d, inTokyo.InLocation(nil))
+}
+
+func TestDate_Format(t *testing.T) {
+	d := testDate(t, "2024-01-15")
+	assert.Equal(t, "January 15, 2024", d.Format("January 2, 2006"))
+	assert.Equal(t, "Mon 15/01/24", d.Format("Mon 02/01/06"))
+	assert.Equal(t, d.String(), d.Format(RFC3339FullDate))
+	assert.Equal(t, "due: 2024-01-15", string(d.AppendFormat([]byte("due: "), RFC3339FullDate)))
+
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-14 16:00 PST", d.FormatLocale("2006-01-02 15:04 MST", losAngeles))
+	assert.Equal(t, "2024-01-15 00:00 UTC", d.FormatLocale("2006-01-02 15:04 MST", nil))
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	inTokyo := d.InLocation(tokyo)
+	assert.Equal(t, "2024-01-15", inTokyo.Format(RFC3339FullDate))
+	assert.Equal(t, "2024-01-14", inTokyo.FormatLocale(RFC3339FullDate, time.UTC))
+}
+
+func TestDate_BSONValue(t *testing.T) {
+	type event struct {
+		Day Date `bson:"day"`
+	}
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	for _, d := range []Date{
+		testDate(t, "2024-01-15"),
+		testDate(t, "1969-07-20"),
+		Date(time.Date(2024, 1, 15, 13, 45, 0, 0, time.UTC)),
+		Date(time.Date(2024, 1, 16, 6, 0, 0, 0, tokyo)), // still 2024-01-15 in UTC
+	} {
+		data, err := bson.Marshal(event{Day: d})
+		require.NoError(t, err)
+		value := bson.Raw(data).Lookup("day")
+		require.Equal(t, bson.TypeDateTime, value.Type)
+		year, month, day := time.Time(d).Date()
+		assert.Equal(t, time.Date(year, month, day, 0, 0, 0, 0, time.UTC), value.Time().UTC())
+
+		var out event
+		require.NoError(t, bson.Unmarshal(data, &out))
+		assert.Equal(t, d.String(), out.Day.String())
+		assert.Equal(t, time.UTC, time.Time(out.Day).Location())
+	}
+
+	// the time of day is truncated, not rounded
+	for _, tc := range []struct {
+		at       time.Time
+		expected string
+	}{
+		{time.Date(2024, 1, 15, 23, 59, 59, 999000000, time.UTC), "2024-01-15"},
+		{time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC), "2024-01-15"},
+		{time.Date(1969, 12, 31, 23, 59, 0, 0, time.UTC), "1969-12-31"},
+	} {
+		data, err := bson.Marshal(bson.M{"day": tc.at})
+		require.NoError(t, err)
+		var out event
+		require.NoError(t, bson.Unmarshal(data, &out))
+		assert.Equal(t, testDate(t, tc.expected), out.Day)
+	}
+
+	data, err := bson.Marshal(bson.M{"day": nil})
+	require.NoError(t, err)
+	out := event{Day: testDate(t, "2024-01-15")}
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.True(t, out.Day.IsZero())
+
+	for _, invalid := range []interface{}{int32(1), "2024-13-01", bson.M{"other": "2024-01-15"}} {
+		data, err = bson.Marshal(bson.M{"day": invalid})
+		require.NoError(t, err)
+		require.Errorf(t, bson.Unmarshal(data, &out), "expected an error decoding %v", invalid)
+	}
+}
+
+func TestDate_MarshalJSONZeroAsNull(t *testing.T) {
+	t.Cleanup(func() { DateMarshalZeroAsNull = false })
+	date := Date(testDate(t, "2024-01-15"))
+
+	b, err := json.Marshal(Date{})
+	require.NoError(t, err)
+	assert.Equal(t, `"0001-01-01"`, string(b))
+
+	b, err = Date{}.MarshalJSONOrNull()
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = date.MarshalJSONOrNull()
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-01-15"`, string(b))
+
+	DateMarshalZeroAsNull = true
+	b, err = json.Marshal(struct {
+		Date     Date  `json:"date"`
+		Optional *Date `json:"optional"`
+	}{Optional: &date})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"date":null,"optional":"2024-01-15"}`, string(b))
+
+	var roundTrip Date
+	require.NoError(t, json.Unmarshal([]byte("null"), &roundTrip))
+	assert.True(t, roundTrip.IsZero())
+}
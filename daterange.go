@@ -0,0 +1,202 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// maxDateRangeDates is the largest number of dates returned by DateRange.Dates
+const maxDateRangeDates = 1 << 16
+
+// ErrDateRangeTooLarge is returned by DateRange.Dates when a range spans more than 65536 days
+var ErrDateRangeTooLarge = errors.New("date range is too large: at most 65536 dates may be listed")
+
+// DateRange represents an inclusive range of dates, from Start to End
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// dateRangeJSON is the JSON representation of a DateRange
+type dateRangeJSON struct {
+	Start Date `json:"start"`
+	End   Date `json:"end"`
+}
+
+// NewDateRange builds a DateRange, returning an error if start is after end
+func NewDateRange(start, end Date) (DateRange, error) {
+	r := DateRange{Start: start, End: end}
+	if err := r.validate(); err != nil {
+		return DateRange{}, err
+	}
+	return r, nil
+}
+
+// dayOf returns the calendar day of a date, at midnight UTC
+func dayOf(d Date) time.Time {
+	y, m, dd := time.Time(d).Date()
+	return time.Date(y, m, dd, 0, 0, 0, 0, time.UTC)
+}
+
+func (r DateRange) validate() error {
+	if dayOf(r.Start).After(dayOf(r.End)) {
+		return fmt.Errorf("invalid date range: start %s is after end %s", r.Start, r.End)
+	}
+	return nil
+}
+
+// Contains returns true when the date is within this range, bounds included
+func (r DateRange) Contains(d Date) bool {
+	day := dayOf(d)
+	return !day.Before(dayOf(r.Start)) && !day.After(dayOf(r.End))
+}
+
+// Overlaps returns true when this range and the other range have at least one day in common
+func (r DateRange) Overlaps(other DateRange) bool {
+	return !dayOf(r.Start).After(dayOf(other.End)) && !dayOf(other.Start).After(dayOf(r.End))
+}
+
+// julianDay returns the number of days between the proleptic Gregorian date of d and 1970-01-01.
+//
+// Unlike time.Time.Sub, this does not saturate for dates more than 292 years apart.
+func julianDay(d Date) int64 {
+	year, month, day := time.Time(d).Date()
+	// shift the year to start in March, so that leap days come last (see http://howardhinnant.github.io/date_algorithms.html)
+	y := int64(year)
+	if month <= time.February {
+		y--
+	}
+	era := y / 400
+	if y < 0 && y%400 != 0 {
+		era--
+	}
+	yoe := y - era*400
+	m := int64(month)
+	if m > 2 {
+		m -= 3
+	} else {
+		m += 9
+	}
+	doy := (153*m+2)/5 + int64(day) - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 719468
+}
+
+// Days returns the number of days in this range, bounds included
+func (r DateRange) Days() int {
+	days := julianDay(r.End) - julianDay(r.Start) + 1
+	if days < 0 {
+		return 0
+	}
+	return int(days)
+}
+
+// Dates returns all the days in this range, bounds included.
+//
+// ErrDateRangeTooLarge is returned when the range spans more than 65536 days (about 179 years).
+func (r DateRange) Dates() ([]Date, error) {
+	days := r.Days()
+	if days == 0 {
+		return nil, nil
+	}
+	if days > maxDateRangeDates {
+		return nil, ErrDateRangeTooLarge
+	}
+	dates := make([]Date, 0, days)
+	start := time.Time(r.Start)
+	for i := 0; i < days; i++ {
+		dates = append(dates, Date(start.AddDate(0, 0, i)))
+	}
+	return dates, nil
+}
+
+// String converts this date range into a string, e.g. "2024-01-01/2024-12-31"
+func (r DateRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// MarshalJSON returns the DateRange as a JSON object, e.g. {"start":"2024-01-01","end":"2024-12-31"}
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dateRangeJSON(r))
+}
+
+// UnmarshalJSON sets the DateRange from a JSON object, e.g. {"start":"2024-01-01","end":"2024-12-31"}
+func (r *DateRange) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var rj dateRangeJSON
+	if err := json.Unmarshal(data, &rj); err != nil {
+		return err
+	}
+	dr := DateRange(rj)
+	if err := dr.validate(); err != nil {
+		return err
+	}
+	*r = dr
+	return nil
+}
+
+// Scan scans a DateRange value from a database driver type.
+//
+// The range is expected as a pair of dates, either as a [2]string, a []string of length 2,
+// or a JSON array of 2 strings.
+func (r *DateRange) Scan(raw interface{}) error {
+	var pair []string
+	switch v := raw.(type) {
+	case [2]string:
+		pair = v[:]
+	case []string:
+		pair = v
+	case []byte:
+		if err := json.Unmarshal(v, &pair); err != nil {
+			return fmt.Errorf("cannot sql.Scan() strfmt.DateRange from: %q: %w", string(v), err)
+		}
+	case string:
+		if err := json.Unmarshal([]byte(v), &pair); err != nil {
+			return fmt.Errorf("cannot sql.Scan() strfmt.DateRange from: %q: %w", v, err)
+		}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.DateRange from: %#v", v)
+	}
+
+	if len(pair) != 2 {
+		return errors.New("cannot sql.Scan() strfmt.DateRange: expected exactly 2 dates")
+	}
+	var dr DateRange
+	if err := dr.Start.UnmarshalText([]byte(pair[0])); err != nil {
+		return err
+	}
+	if err := dr.End.UnmarshalText([]byte(pair[1])); err != nil {
+		return err
+	}
+	if err := dr.validate(); err != nil {
+		return err
+	}
+	*r = dr
+	return nil
+}
+
+// Value converts DateRange to a [2]string value (start, end) ready to be written to a database.
+//
+// The database driver must support array values (e.g. via driver.NamedValueChecker).
+func (r DateRange) Value() (driver.Value, error) {
+	return driver.Value([2]string{r.Start.String(), r.End.String()}), nil
+}
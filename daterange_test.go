@@ -0,0 +1,187 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDate(t *testing.T, str string) Date {
+	t.Helper()
+	var d Date
+	require.NoError(t, d.UnmarshalText([]byte(str)))
+	return d
+}
+
+func TestNewDateRange(t *testing.T) {
+	r, err := NewDateRange(testDate(t, "2024-01-01"), testDate(t, "2024-12-31"))
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01/2024-12-31", r.String())
+
+	_, err = NewDateRange(testDate(t, "2024-01-01"), testDate(t, "2024-01-01"))
+	require.NoError(t, err)
+
+	_, err = NewDateRange(testDate(t, "2024-01-02"), testDate(t, "2024-01-01"))
+	require.Error(t, err)
+}
+
+func TestDateRange_Contains(t *testing.T) {
+	r, err := NewDateRange(testDate(t, "2024-01-10"), testDate(t, "2024-01-20"))
+	require.NoError(t, err)
+
+	assert.True(t, r.Contains(testDate(t, "2024-01-10")))
+	assert.True(t, r.Contains(testDate(t, "2024-01-15")))
+	assert.True(t, r.Contains(testDate(t, "2024-01-20")))
+	assert.False(t, r.Contains(testDate(t, "2024-01-09")))
+	assert.False(t, r.Contains(testDate(t, "2024-01-21")))
+
+	// the time of day is ignored
+	assert.True(t, r.Contains(Date(time.Date(2024, 1, 20, 23, 59, 59, 0, time.UTC))))
+}
+
+func TestDateRange_Overlaps(t *testing.T) {
+	r, err := NewDateRange(testDate(t, "2024-01-10"), testDate(t, "2024-01-20"))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		start, end string
+		expected   bool
+	}{
+		{"2024-01-01", "2024-01-09", false},
+		{"2024-01-01", "2024-01-10", true},
+		{"2024-01-12", "2024-01-15", true},
+		{"2024-01-01", "2024-01-31", true},
+		{"2024-01-20", "2024-01-31", true},
+		{"2024-01-21", "2024-01-31", false},
+	} {
+		other, err := NewDateRange(testDate(t, tc.start), testDate(t, tc.end))
+		require.NoError(t, err)
+		assert.Equalf(t, tc.expected, r.Overlaps(other), "unexpected overlap of %v with %v", r, other)
+		assert.Equalf(t, tc.expected, other.Overlaps(r), "unexpected overlap of %v with %v", other, r)
+	}
+}
+
+func TestDateRange_Dates(t *testing.T) {
+	r, err := NewDateRange(testDate(t, "2024-02-27"), testDate(t, "2024-03-02"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, r.Days())
+	dates, err := r.Dates()
+	require.NoError(t, err)
+	require.Len(t, dates, 5)
+	for i, expected := range []string{"2024-02-27", "2024-02-28", "2024-02-29", "2024-03-01", "2024-03-02"} {
+		assert.Equal(t, expected, dates[i].String())
+	}
+
+	single, err := NewDateRange(testDate(t, "2024-01-01"), testDate(t, "2024-01-01"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, single.Days())
+	dates, err = single.Dates()
+	require.NoError(t, err)
+	assert.Len(t, dates, 1)
+
+	year, err := NewDateRange(testDate(t, "2024-01-01"), testDate(t, "2024-12-31"))
+	require.NoError(t, err)
+	assert.Equal(t, 366, year.Days())
+
+	inverted := DateRange{Start: testDate(t, "2024-01-02"), End: testDate(t, "2024-01-01")}
+	assert.Equal(t, 0, inverted.Days())
+	dates, err = inverted.Dates()
+	require.NoError(t, err)
+	assert.Nil(t, dates)
+}
+
+func TestDateRange_LargeRanges(t *testing.T) {
+	for _, tc := range []struct {
+		start time.Time
+		end   string
+		days  int
+	}{
+		{time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC), "1970-01-01", 1},
+		{time.Date(1969, time.December, 31, 0, 0, 0, 0, time.UTC), "1970-01-01", 2},
+		{time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), "2100-12-31", 73414},
+		{time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), "9999-12-31", 3652059},
+		{time.Date(-400, time.March, 1, 0, 0, 0, 0, time.UTC), "0400-02-29", 292194},
+	} {
+		r, err := NewDateRange(Date(tc.start), testDate(t, tc.end))
+		require.NoError(t, err)
+		assert.Equalf(t, tc.days, r.Days(), "unexpected number of days from %v to %s", tc.start, tc.end)
+	}
+
+	// ranges over 292 years do not saturate
+	r := DateRange{Start: Date(time.Date(-100000, time.January, 1, 0, 0, 0, 0, time.UTC)), End: testDate(t, "2024-01-01")}
+	assert.Greater(t, r.Days(), 36_000_000)
+
+	r, err := NewDateRange(testDate(t, "1900-01-01"), testDate(t, "2100-12-31"))
+	require.NoError(t, err)
+	_, err = r.Dates()
+	require.ErrorIs(t, err, ErrDateRangeTooLarge)
+
+	r, err = NewDateRange(testDate(t, "2000-01-01"), Date(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, maxDateRangeDates-1)))
+	require.NoError(t, err)
+	dates, err := r.Dates()
+	require.NoError(t, err)
+	assert.Len(t, dates, maxDateRangeDates)
+}
+
+func TestDateRange_JSON(t *testing.T) {
+	r, err := NewDateRange(testDate(t, "2024-01-01"), testDate(t, "2024-12-31"))
+	require.NoError(t, err)
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"start":"2024-01-01","end":"2024-12-31"}`, string(b))
+
+	var decoded DateRange
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, r.String(), decoded.String())
+
+	require.NoError(t, decoded.UnmarshalJSON([]byte(jsonNull)))
+	assert.Equal(t, r.String(), decoded.String())
+
+	require.Error(t, json.Unmarshal([]byte(`{"start":"2024-12-31","end":"2024-01-01"}`), &decoded))
+	require.Error(t, json.Unmarshal([]byte(`{"start":"2024-13-01","end":"2024-12-31"}`), &decoded))
+	require.Error(t, json.Unmarshal([]byte(`["2024-01-01","2024-12-31"]`), &decoded))
+}
+
+func TestDateRange_Scan(t *testing.T) {
+	for _, raw := range []interface{}{
+		[2]string{"2024-01-01", "2024-12-31"},
+		[]string{"2024-01-01", "2024-12-31"},
+		`["2024-01-01","2024-12-31"]`,
+		[]byte(`["2024-01-01","2024-12-31"]`),
+	} {
+		var r DateRange
+		require.NoError(t, r.Scan(raw))
+		assert.Equal(t, "2024-01-01/2024-12-31", r.String())
+	}
+
+	var r DateRange
+	require.Error(t, r.Scan([]string{"2024-01-01"}))
+	require.Error(t, r.Scan([2]string{"2024-12-31", "2024-01-01"}))
+	require.Error(t, r.Scan([2]string{"2024-01-01", "not a date"}))
+	require.Error(t, r.Scan("2024-01-01/2024-12-31"))
+	require.Error(t, r.Scan(int64(1)))
+
+	require.NoError(t, r.Scan([2]string{"2024-01-01", "2024-12-31"}))
+	v, err := r.Value()
+	require.NoError(t, err)
+	assert.Equal(t, [2]string{"2024-01-01", "2024-12-31"}, v)
+}
@@ -0,0 +1,158 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateTimeRange represents a closed interval of time, from Start to End (both included)
+type DateTimeRange struct {
+	Start DateTime
+	End   DateTime
+}
+
+// dateTimeRangeJSON is the JSON representation of a DateTimeRange
+type dateTimeRangeJSON struct {
+	Start DateTime `json:"start"`
+	End   DateTime `json:"end"`
+}
+
+// NewDateTimeRange builds a DateTimeRange, returning an error if start is after end
+func NewDateTimeRange(start, end DateTime) (DateTimeRange, error) {
+	r := DateTimeRange{Start: start, End: end}
+	if err := r.validate(); err != nil {
+		return DateTimeRange{}, err
+	}
+	return r, nil
+}
+
+func (r DateTimeRange) validate() error {
+	if time.Time(r.Start).After(time.Time(r.End)) {
+		return fmt.Errorf("invalid date-time range: start %s is after end %s", r.Start, r.End)
+	}
+	return nil
+}
+
+// Contains returns true when the date-time is within this range, bounds included
+func (r DateTimeRange) Contains(dt DateTime) bool {
+	t := time.Time(dt)
+	return !t.Before(time.Time(r.Start)) && !t.After(time.Time(r.End))
+}
+
+// Overlaps returns true when this range and the other range have at least one instant in common
+func (r DateTimeRange) Overlaps(other DateTimeRange) bool {
+	return !time.Time(r.Start).After(time.Time(other.End)) && !time.Time(other.Start).After(time.Time(r.End))
+}
+
+// Duration returns the duration between the start and the end of this range
+func (r DateTimeRange) Duration() Duration {
+	return Duration(time.Time(r.End).Sub(time.Time(r.Start)))
+}
+
+// Clip returns the date-time if it is within this range, or the nearest bound of this range otherwise
+func (r DateTimeRange) Clip(dt DateTime) DateTime {
+	t := time.Time(dt)
+	switch {
+	case t.Before(time.Time(r.Start)):
+		return r.Start
+	case t.After(time.Time(r.End)):
+		return r.End
+	default:
+		return dt
+	}
+}
+
+// String converts this range into a string, e.g. "2024-01-01T00:00:00.000Z/2024-01-02T00:00:00.000Z"
+func (r DateTimeRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
+
+// MarshalJSON returns the DateTimeRange as a JSON object with RFC 3339 "start" and "end" keys
+func (r DateTimeRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dateTimeRangeJSON{Start: r.Start, End: r.End})
+}
+
+// UnmarshalJSON sets the DateTimeRange from a JSON object with RFC 3339 "start" and "end" keys
+func (r *DateTimeRange) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var rj dateTimeRangeJSON
+	if err := json.Unmarshal(data, &rj); err != nil {
+		return err
+	}
+	dr := DateTimeRange{Start: rj.Start, End: rj.End}
+	if err := dr.validate(); err != nil {
+		return err
+	}
+	*r = dr
+	return nil
+}
+
+// Scan scans a DateTimeRange value from a database driver type.
+//
+// A sql.Scanner reads a single column: the range cannot be scanned from two consecutive rows or columns,
+// one for each bound. It is scanned instead from a single composite value holding both bounds, as written by Value:
+// a pair of RFC 3339 date-times, either as a [2]string, a []string of length 2, or a JSON array of 2 strings
+// such as ["2024-01-01T00:00:00.000Z","2024-01-02T00:00:00.000Z"] (e.g. from a JSON or text array column).
+//
+// The range is left unchanged when the value cannot be scanned.
+func (r *DateTimeRange) Scan(raw interface{}) error {
+	var pair []string
+	switch v := raw.(type) {
+	case [2]string:
+		pair = v[:]
+	case []string:
+		pair = v
+	case []byte:
+		if err := json.Unmarshal(v, &pair); err != nil {
+			return fmt.Errorf("cannot sql.Scan() strfmt.DateTimeRange from: %q: %w", string(v), err)
+		}
+	case string:
+		if err := json.Unmarshal([]byte(v), &pair); err != nil {
+			return fmt.Errorf("cannot sql.Scan() strfmt.DateTimeRange from: %q: %w", v, err)
+		}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.DateTimeRange from: %#v", v)
+	}
+
+	if len(pair) != 2 {
+		return errors.New("cannot sql.Scan() strfmt.DateTimeRange: expected exactly 2 date-times")
+	}
+	var dr DateTimeRange
+	if err := dr.Start.UnmarshalText([]byte(pair[0])); err != nil {
+		return err
+	}
+	if err := dr.End.UnmarshalText([]byte(pair[1])); err != nil {
+		return err
+	}
+	if err := dr.validate(); err != nil {
+		return err
+	}
+	*r = dr
+	return nil
+}
+
+// Value converts DateTimeRange to a [2]string value (start, end) ready to be written to a database.
+//
+// The database driver must support array values (e.g. via driver.NamedValueChecker).
+func (r DateTimeRange) Value() (driver.Value, error) {
+	return driver.Value([2]string{r.Start.marshalString(), r.End.marshalString()}), nil
+}
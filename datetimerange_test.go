@@ -0,0 +1,157 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDateTime(t *testing.T, str string) DateTime {
+	t.Helper()
+	dt, err := ParseDateTime(str)
+	require.NoError(t, err)
+	return dt
+}
+
+func TestNewDateTimeRange(t *testing.T) {
+	r, err := NewDateTimeRange(testDateTime(t, "2024-01-01T00:00:00Z"), testDateTime(t, "2024-01-02T12:00:00Z"))
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01T00:00:00.000Z/2024-01-02T12:00:00.000Z", r.String())
+	assert.Equal(t, Duration(36*time.Hour), r.Duration())
+
+	empty, err := NewDateTimeRange(testDateTime(t, "2024-01-01T00:00:00Z"), testDateTime(t, "2024-01-01T01:00:00+01:00"))
+	require.NoError(t, err)
+	assert.Equal(t, Duration(0), empty.Duration())
+
+	_, err = NewDateTimeRange(testDateTime(t, "2024-01-01T00:00:01Z"), testDateTime(t, "2024-01-01T00:00:00Z"))
+	require.Error(t, err)
+}
+
+func TestDateTimeRange_Contains(t *testing.T) {
+	r, err := NewDateTimeRange(testDateTime(t, "2024-01-01T10:00:00Z"), testDateTime(t, "2024-01-01T12:00:00Z"))
+	require.NoError(t, err)
+
+	assert.True(t, r.Contains(testDateTime(t, "2024-01-01T10:00:00Z")))
+	assert.True(t, r.Contains(testDateTime(t, "2024-01-01T11:00:00Z")))
+	assert.True(t, r.Contains(testDateTime(t, "2024-01-01T12:00:00Z")))
+	assert.True(t, r.Contains(testDateTime(t, "2024-01-01T12:00:00+01:00")))
+	assert.False(t, r.Contains(testDateTime(t, "2024-01-01T09:59:59Z")))
+	assert.False(t, r.Contains(testDateTime(t, "2024-01-01T12:00:00.001Z")))
+}
+
+func TestDateTimeRange_Overlaps(t *testing.T) {
+	r, err := NewDateTimeRange(testDateTime(t, "2024-01-01T10:00:00Z"), testDateTime(t, "2024-01-01T12:00:00Z"))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		start, end string
+		expected   bool
+	}{
+		{"2024-01-01T08:00:00Z", "2024-01-01T09:59:59Z", false},
+		{"2024-01-01T08:00:00Z", "2024-01-01T10:00:00Z", true},
+		{"2024-01-01T10:30:00Z", "2024-01-01T11:30:00Z", true},
+		{"2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z", true},
+		{"2024-01-01T12:00:00Z", "2024-01-01T13:00:00Z", true},
+		{"2024-01-01T12:00:01Z", "2024-01-01T13:00:00Z", false},
+	} {
+		other, err := NewDateTimeRange(testDateTime(t, tc.start), testDateTime(t, tc.end))
+		require.NoError(t, err)
+		assert.Equalf(t, tc.expected, r.Overlaps(other), "unexpected overlap of %v with %v", r, other)
+		assert.Equalf(t, tc.expected, other.Overlaps(r), "unexpected overlap of %v with %v", other, r)
+	}
+}
+
+func TestDateTimeRange_Clip(t *testing.T) {
+	r, err := NewDateTimeRange(testDateTime(t, "2024-01-01T10:00:00Z"), testDateTime(t, "2024-01-01T12:00:00Z"))
+	require.NoError(t, err)
+
+	assert.Equal(t, r.Start, r.Clip(testDateTime(t, "2023-12-31T10:00:00Z")))
+	assert.Equal(t, r.End, r.Clip(testDateTime(t, "2024-01-01T12:00:01Z")))
+	inside := testDateTime(t, "2024-01-01T11:00:00Z")
+	assert.Equal(t, inside, r.Clip(inside))
+	assert.Equal(t, r.Start, r.Clip(r.Start))
+}
+
+func TestDateTimeRange_JSON(t *testing.T) {
+	r, err := NewDateTimeRange(testDateTime(t, "2024-01-01T10:00:00Z"), testDateTime(t, "2024-01-01T12:00:00Z"))
+	require.NoError(t, err)
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"start":"2024-01-01T10:00:00.000Z","end":"2024-01-01T12:00:00.000Z"}`, string(b))
+
+	var decoded DateTimeRange
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.True(t, r.Start.Equal(decoded.Start))
+	assert.True(t, r.End.Equal(decoded.End))
+
+	require.NoError(t, decoded.UnmarshalJSON([]byte(jsonNull)))
+	assert.True(t, r.Start.Equal(decoded.Start))
+
+	require.Error(t, json.Unmarshal([]byte(`{"start":"2024-01-02T00:00:00Z","end":"2024-01-01T00:00:00Z"}`), &decoded))
+	require.Error(t, json.Unmarshal([]byte(`{"start":"yesterday","end":"2024-01-01T00:00:00Z"}`), &decoded))
+}
+
+func TestDateTimeRange_Scan(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	expected, err := NewDateTimeRange(DateTime(start), DateTime(end))
+	require.NoError(t, err)
+
+	for _, raw := range []interface{}{
+		[2]string{"2024-01-01T10:00:00Z", "2024-01-01T12:00:00Z"},
+		[]string{"2024-01-01T10:00:00.000Z", "2024-01-01T12:00:00Z"},
+		[]byte(`["2024-01-01T10:00:00Z","2024-01-01T12:00:00Z"]`),
+		`["2024-01-01T10:00:00Z","2024-01-01T12:00:00Z"]`,
+	} {
+		var r DateTimeRange
+		require.NoErrorf(t, r.Scan(raw), "expected %v to be scanned", raw)
+		assert.True(t, expected.Start.Equal(r.Start))
+		assert.True(t, expected.End.Equal(r.End))
+	}
+
+	// round trip
+	value, err := expected.Value()
+	require.NoError(t, err)
+	assert.Equal(t, [2]string{"2024-01-01T10:00:00.000Z", "2024-01-01T12:00:00.000Z"}, value)
+	var r DateTimeRange
+	require.NoError(t, r.Scan(value))
+	assert.Equal(t, expected, r)
+
+	// ranges with equal bounds are equal after a scan
+	other, err := NewDateTimeRange(DateTime(start), DateTime(end))
+	require.NoError(t, err)
+	assert.True(t, r == other)
+
+	// failed scans leave the range untouched
+	for _, invalid := range []interface{}{
+		[2]string{"2024-01-01T12:00:00Z", "2024-01-01T10:00:00Z"},
+		[]string{"2024-01-01T10:00:00Z"},
+		[2]string{"yesterday", "2024-01-01T10:00:00Z"},
+		[2]string{"2024-01-01T10:00:00Z", "tomorrow"},
+		"2024-01-01T10:00:00Z",
+		[]byte("not json"),
+		start,
+		1.5,
+	} {
+		require.Errorf(t, r.Scan(invalid), "expected %v not to be scanned", invalid)
+		assert.Equal(t, expected, r)
+	}
+}
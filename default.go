@@ -15,18 +15,30 @@
 package strfmt
 
 import (
+	"bytes"
+	"crypto/rand"
 	"database/sql/driver"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net"
 	"net/mail"
 	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	bsonprim "go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/net/idna"
 )
 
 const (
@@ -59,6 +71,9 @@ const (
 	//   - symbol unicode points are permitted (e.g. emoji) (not for top-level domain)
 	HostnamePattern = `^([a-zA-Z0-9\p{S}\p{L}]((-?[a-zA-Z0-9\p{S}\p{L}]{0,62})?)|([a-zA-Z0-9\p{S}\p{L}](([a-zA-Z0-9-\p{S}\p{L}]{0,61}[a-zA-Z0-9\p{S}\p{L}])?)(\.)){1,}([a-zA-Z\p{L}]){2,63})$`
 
+	// SSNPattern to check a social security number, with dashes, spaces or no separators (separators may not be mixed)
+	SSNPattern = `^[0-9]{3}(-[0-9]{2}-| [0-9]{2} |[0-9]{2})[0-9]{4}$`
+
 	// json null type
 	jsonNull = "null"
 )
@@ -87,10 +102,14 @@ const (
 
 var (
 	rxHostname = regexp.MustCompile(HostnamePattern)
+	rxSSN      = regexp.MustCompile(SSNPattern)
 )
 
-// IsHostname returns true when the string is a valid hostname
+// IsHostname returns true when the string is a valid hostname.
+//
+// A single trailing dot, denoting a fully qualified domain name (e.g. "example.com."), is accepted.
 func IsHostname(str string) bool {
+	str = strings.TrimSuffix(str, ".")
 	if !rxHostname.MatchString(str) {
 		return false
 	}
@@ -135,7 +154,29 @@ func IsUUID5(str string) bool {
 	return err == nil && id.Version() == uuid.Version(5)
 }
 
+// IsUUID6 returns true is the string matches a UUID v6, upper case is allowed
+func IsUUID6(str string) bool {
+	id, err := uuid.Parse(str)
+	return err == nil && id.Version() == uuid.Version(6)
+}
+
+// IsUUID7 returns true is the string matches a UUID v7, upper case is allowed
+func IsUUID7(str string) bool {
+	id, err := uuid.Parse(str)
+	return err == nil && id.Version() == uuid.Version(7)
+}
+
+// IsSSN returns true when the string has the format of a social security number.
+//
+// The 3 groups of digits may be separated by dashes or spaces, e.g. "nnn-nn-nnnn", "nnn nn nnnn" or "nnnnnnnnn".
+// See SSN.IsValid to also check the area, group and serial numbers.
+func IsSSN(str string) bool {
+	return rxSSN.MatchString(str)
+}
+
 // IsEmail validates an email address.
+//
+// See ValidateEmail to know why an address is not valid.
 func IsEmail(str string) bool {
 	addr, e := mail.ParseAddress(str)
 	return e == nil && addr.Address != ""
@@ -144,6 +185,8 @@ func IsEmail(str string) bool {
 func init() {
 	// register formats in the default registry:
 	//   - byte
+	//   - byte-std
+	//   - byte-url
 	//   - creditcard
 	//   - email
 	//   - hexcolor
@@ -163,65 +206,134 @@ func init() {
 	//   - uuid3
 	//   - uuid4
 	//   - uuid5
+	//   - uuid6
+	//   - uuid7
 	u := URI("")
-	Default.Add("uri", &u, govalidator.IsRequestURI)
+	addBuiltin("uri", &u, govalidator.IsRequestURI)
 
 	eml := Email("")
-	Default.Add("email", &eml, IsEmail)
+	addBuiltinContextual("email", &eml, validateEmailFormat)
 
 	hn := Hostname("")
-	Default.Add("hostname", &hn, IsHostname)
+	addBuiltin("hostname", &hn, IsHostname)
 
 	ip4 := IPv4("")
-	Default.Add("ipv4", &ip4, govalidator.IsIPv4)
+	addBuiltin("ipv4", &ip4, govalidator.IsIPv4)
 
 	ip6 := IPv6("")
-	Default.Add("ipv6", &ip6, govalidator.IsIPv6)
+	addBuiltin("ipv6", &ip6, govalidator.IsIPv6)
 
 	cidr := CIDR("")
-	Default.Add("cidr", &cidr, govalidator.IsCIDR)
+	addBuiltin("cidr", &cidr, govalidator.IsCIDR)
 
 	mac := MAC("")
-	Default.Add("mac", &mac, govalidator.IsMAC)
+	addBuiltin("mac", &mac, IsMAC)
 
 	uid := UUID("")
-	Default.Add("uuid", &uid, IsUUID)
+	addBuiltin("uuid", &uid, IsUUID)
 
 	uid3 := UUID3("")
-	Default.Add("uuid3", &uid3, IsUUID3)
+	addBuiltin("uuid3", &uid3, IsUUID3)
 
 	uid4 := UUID4("")
-	Default.Add("uuid4", &uid4, IsUUID4)
+	addBuiltin("uuid4", &uid4, IsUUID4)
 
 	uid5 := UUID5("")
-	Default.Add("uuid5", &uid5, IsUUID5)
+	addBuiltin("uuid5", &uid5, IsUUID5)
+
+	uid6 := UUID6("")
+	addBuiltin("uuid6", &uid6, IsUUID6)
+
+	uid7 := UUID7("")
+	addBuiltin("uuid7", &uid7, IsUUID7)
 
 	isbn := ISBN("")
-	Default.Add("isbn", &isbn, func(str string) bool { return govalidator.IsISBN10(str) || govalidator.IsISBN13(str) })
+	addBuiltin("isbn", &isbn, func(str string) bool { return govalidator.IsISBN10(str) || govalidator.IsISBN13(str) })
 
 	isbn10 := ISBN10("")
-	Default.Add("isbn10", &isbn10, govalidator.IsISBN10)
+	addBuiltin("isbn10", &isbn10, govalidator.IsISBN10)
 
 	isbn13 := ISBN13("")
-	Default.Add("isbn13", &isbn13, govalidator.IsISBN13)
+	addBuiltin("isbn13", &isbn13, govalidator.IsISBN13)
 
 	cc := CreditCard("")
-	Default.Add("creditcard", &cc, govalidator.IsCreditCard)
+	addBuiltin("creditcard", &cc, govalidator.IsCreditCard)
 
 	ssn := SSN("")
-	Default.Add("ssn", &ssn, govalidator.IsSSN)
+	addBuiltin("ssn", &ssn, IsSSN)
 
 	hc := HexColor("")
-	Default.Add("hexcolor", &hc, govalidator.IsHexcolor)
+	addBuiltin("hexcolor", &hc, govalidator.IsHexcolor)
 
 	rc := RGBColor("")
-	Default.Add("rgbcolor", &rc, govalidator.IsRGBcolor)
+	addBuiltin("rgbcolor", &rc, govalidator.IsRGBcolor)
 
 	b64 := Base64([]byte(nil))
-	Default.Add("byte", &b64, govalidator.IsBase64)
+	addBuiltin("byte", &b64, govalidator.IsBase64)
+
+	b64std := Base64Std([]byte(nil))
+	addBuiltin("byte-std", &b64std, IsBase64Std)
+
+	b64url := Base64URL([]byte(nil))
+	addBuiltin("byte-url", &b64url, IsBase64URL)
 
 	pw := Password("")
-	Default.Add("password", &pw, func(_ string) bool { return true })
+	addBuiltin("password", &pw, func(_ string) bool { return true })
+}
+
+// marshalBSONString renders a string-based format as a BSON string value
+func marshalBSONString(str string) (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(str)
+}
+
+// unmarshalBSONString reads a string-based format from a BSON string value.
+//
+// Documents such as {"data": "..."}, as rendered by MarshalBSON, are accepted as well.
+func unmarshalBSONString(tpe bsontype.Type, data []byte, name string) (string, error) {
+	raw := bson.RawValue{Type: tpe, Value: data}
+	switch tpe {
+	case bson.TypeNull:
+		return "", nil
+	case bson.TypeString:
+		if str, ok := raw.StringValueOK(); ok {
+			return str, nil
+		}
+	case bson.TypeEmbeddedDocument:
+		if doc, ok := raw.DocumentOK(); ok {
+			if str, ok := doc.Lookup("data").StringValueOK(); ok {
+				return str, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("couldn't unmarshal bson value of type %s as %s", tpe, name)
+}
+
+// marshalBSONBinary renders a binary format as BSON generic binary data
+func marshalBSONBinary(b []byte) (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(bsonprim.Binary{Subtype: bsontype.BinaryGeneric, Data: b})
+}
+
+// unmarshalBSONBinary reads a binary format from BSON binary data.
+//
+// Documents such as {"data": "..."}, as rendered by MarshalBSON, are accepted as well:
+// the string data is then decoded with the given decoding function.
+func unmarshalBSONBinary(tpe bsontype.Type, data []byte, name string, decode func(string) ([]byte, error)) ([]byte, error) {
+	raw := bson.RawValue{Type: tpe, Value: data}
+	switch tpe {
+	case bson.TypeNull:
+		return nil, nil
+	case bson.TypeBinary:
+		if _, b, ok := raw.BinaryOK(); ok {
+			return append([]byte(nil), b...), nil
+		}
+	case bson.TypeEmbeddedDocument:
+		if doc, ok := raw.DocumentOK(); ok {
+			if str, ok := doc.Lookup("data").StringValueOK(); ok {
+				return decode(str)
+			}
+		}
+	}
+	return nil, fmt.Errorf("couldn't unmarshal bson value of type %s as %s", tpe, name)
 }
 
 // Base64 represents a base64 encoded string, using URLEncoding alphabet
@@ -326,6 +438,21 @@ func (b *Base64) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as base64")
 }
 
+// MarshalBSONValue marshals this Base64 as BSON generic binary data
+func (b Base64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONBinary([]byte(b))
+}
+
+// UnmarshalBSONValue reads this Base64 from BSON binary data
+func (b *Base64) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	vb, err := unmarshalBSONBinary(tpe, data, "Base64", base64.StdEncoding.DecodeString)
+	if err != nil {
+		return err
+	}
+	*b = Base64(vb)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (b *Base64) DeepCopyInto(out *Base64) {
 	*out = *b
@@ -341,6 +468,343 @@ func (b *Base64) DeepCopy() *Base64 {
 	return out
 }
 
+// Bytes returns the decoded content of this Base64.
+//
+// The Base64 type holds decoded bytes: the encoding is applied when marshaling.
+func (b Base64) Bytes() ([]byte, error) {
+	return []byte(b), nil
+}
+
+// Len returns the length of the decoded content of this Base64
+func (b Base64) Len() (int, error) {
+	return len(b), nil
+}
+
+// Equal returns true when this Base64 and other hold the same decoded content.
+//
+// Since the Base64 type holds decoded bytes, values unmarshaled from different encodings
+// (standard or URL-safe alphabet, with or without padding) of the same content are equal.
+func (b Base64) Equal(other Base64) bool {
+	ob, err := other.Bytes()
+	if err != nil {
+		return false
+	}
+	return b.EqualBytes(ob)
+}
+
+// EqualBytes returns true when the decoded content of this Base64 is equal to p
+func (b Base64) EqualBytes(p []byte) bool {
+	content, err := b.Bytes()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(content, p)
+}
+
+// DeepEqual is a synonym for Equal
+func (b Base64) DeepEqual(other Base64) bool {
+	return b.Equal(other)
+}
+
+// Reader returns a reader over the decoded content of this Base64.
+//
+// Since the Base64 type holds decoded bytes, no decoding takes place when reading.
+// Use a Base64Writer to stream the encoded form to a writer.
+func (b Base64) Reader() (io.Reader, error) {
+	return bytes.NewReader(b), nil
+}
+
+// AppendFrom reads r until EOF and appends the content read to this Base64.
+//
+// The content is encoded (with the standard alphabet) when this Base64 is marshaled.
+func (b *Base64) AppendFrom(r io.Reader) error {
+	buf := bytes.NewBuffer(*b)
+	if _, err := buf.ReadFrom(r); err != nil {
+		return err
+	}
+	*b = buf.Bytes()
+	return nil
+}
+
+// Base64Writer base64-encodes with the standard alphabet all data written to it,
+// and writes the encoded form to an underlying writer.
+//
+// The last partial block is only written by Close.
+type Base64Writer struct {
+	enc io.WriteCloser
+}
+
+// NewBase64Writer creates a Base64Writer writing to w
+func NewBase64Writer(w io.Writer) *Base64Writer {
+	return &Base64Writer{enc: base64.NewEncoder(base64.StdEncoding, w)}
+}
+
+// Write encodes p to the underlying writer
+func (w *Base64Writer) Write(p []byte) (int, error) {
+	return w.enc.Write(p)
+}
+
+// Close flushes any partially encoded block to the underlying writer.
+//
+// It does not close the underlying writer.
+func (w *Base64Writer) Close() error {
+	return w.enc.Close()
+}
+
+// IsBase64Std returns true when the string is base64 encoded with the standard alphabet (with '+' and '/')
+func IsBase64Std(str string) bool {
+	_, err := base64.StdEncoding.DecodeString(str)
+	return err == nil
+}
+
+// IsBase64URL returns true when the string is base64 encoded with the URL-safe alphabet (with '-' and '_')
+func IsBase64URL(str string) bool {
+	_, err := base64.URLEncoding.DecodeString(str)
+	return err == nil
+}
+
+// Base64Std represents a base64 encoded string, using the standard alphabet
+//
+// swagger:strfmt byte-std
+type Base64Std []byte
+
+// MarshalText turns this instance into text
+func (b Base64Std) MarshalText() ([]byte, error) {
+	enc := base64.StdEncoding
+	src := []byte(b)
+	buf := make([]byte, enc.EncodedLen(len(src)))
+	enc.Encode(buf, src)
+	return buf, nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (b *Base64Std) UnmarshalText(data []byte) error { // validation is performed later on
+	enc := base64.StdEncoding
+	dbuf := make([]byte, enc.DecodedLen(len(data)))
+
+	n, err := enc.Decode(dbuf, data)
+	if err != nil {
+		return err
+	}
+
+	*b = dbuf[:n]
+	return nil
+}
+
+// Scan read a value from a database driver
+func (b *Base64Std) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return b.UnmarshalText(v)
+	case string:
+		return b.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Base64Std from: %#v", v)
+	}
+}
+
+// Value converts a value to a database driver value
+func (b Base64Std) Value() (driver.Value, error) {
+	return driver.Value(b.String()), nil
+}
+
+func (b Base64Std) String() string {
+	return base64.StdEncoding.EncodeToString([]byte(b))
+}
+
+// MarshalJSON returns the Base64Std as JSON
+func (b Base64Std) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON sets the Base64Std from JSON
+func (b *Base64Std) UnmarshalJSON(data []byte) error {
+	var b64str string
+	if err := json.Unmarshal(data, &b64str); err != nil {
+		return err
+	}
+	return b.UnmarshalText([]byte(b64str))
+}
+
+// MarshalBSON document from this value
+func (b Base64Std) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": b.String()})
+}
+
+// UnmarshalBSON document into this value
+func (b *Base64Std) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if bd, ok := m["data"].(string); ok {
+		return b.UnmarshalText([]byte(bd))
+	}
+	return errors.New("couldn't unmarshal bson bytes as base64")
+}
+
+// MarshalBSONValue marshals this Base64Std as BSON generic binary data
+func (b Base64Std) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONBinary([]byte(b))
+}
+
+// UnmarshalBSONValue reads this Base64Std from BSON binary data
+func (b *Base64Std) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	vb, err := unmarshalBSONBinary(tpe, data, "Base64Std", base64.StdEncoding.DecodeString)
+	if err != nil {
+		return err
+	}
+	*b = Base64Std(vb)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (b *Base64Std) DeepCopyInto(out *Base64Std) {
+	*out = *b
+}
+
+// DeepCopy copies the receiver into a new Base64Std.
+func (b *Base64Std) DeepCopy() *Base64Std {
+	if b == nil {
+		return nil
+	}
+	out := new(Base64Std)
+	b.DeepCopyInto(out)
+	return out
+}
+
+// Bytes returns the decoded content of this Base64Std
+func (b Base64Std) Bytes() ([]byte, error) {
+	return []byte(b), nil
+}
+
+// Len returns the length of the decoded content of this Base64Std
+func (b Base64Std) Len() (int, error) {
+	return len(b), nil
+}
+
+// Base64URL represents a base64 encoded string, using the URL-safe alphabet
+//
+// swagger:strfmt byte-url
+type Base64URL []byte
+
+// MarshalText turns this instance into text
+func (b Base64URL) MarshalText() ([]byte, error) {
+	enc := base64.URLEncoding
+	src := []byte(b)
+	buf := make([]byte, enc.EncodedLen(len(src)))
+	enc.Encode(buf, src)
+	return buf, nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (b *Base64URL) UnmarshalText(data []byte) error { // validation is performed later on
+	enc := base64.URLEncoding
+	dbuf := make([]byte, enc.DecodedLen(len(data)))
+
+	n, err := enc.Decode(dbuf, data)
+	if err != nil {
+		return err
+	}
+
+	*b = dbuf[:n]
+	return nil
+}
+
+// Scan read a value from a database driver
+func (b *Base64URL) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return b.UnmarshalText(v)
+	case string:
+		return b.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Base64URL from: %#v", v)
+	}
+}
+
+// Value converts a value to a database driver value
+func (b Base64URL) Value() (driver.Value, error) {
+	return driver.Value(b.String()), nil
+}
+
+func (b Base64URL) String() string {
+	return base64.URLEncoding.EncodeToString([]byte(b))
+}
+
+// MarshalJSON returns the Base64URL as JSON
+func (b Base64URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON sets the Base64URL from JSON
+func (b *Base64URL) UnmarshalJSON(data []byte) error {
+	var b64str string
+	if err := json.Unmarshal(data, &b64str); err != nil {
+		return err
+	}
+	return b.UnmarshalText([]byte(b64str))
+}
+
+// MarshalBSON document from this value
+func (b Base64URL) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": b.String()})
+}
+
+// UnmarshalBSON document into this value
+func (b *Base64URL) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if bd, ok := m["data"].(string); ok {
+		return b.UnmarshalText([]byte(bd))
+	}
+	return errors.New("couldn't unmarshal bson bytes as base64")
+}
+
+// MarshalBSONValue marshals this Base64URL as BSON generic binary data
+func (b Base64URL) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONBinary([]byte(b))
+}
+
+// UnmarshalBSONValue reads this Base64URL from BSON binary data
+func (b *Base64URL) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	vb, err := unmarshalBSONBinary(tpe, data, "Base64URL", base64.URLEncoding.DecodeString)
+	if err != nil {
+		return err
+	}
+	*b = Base64URL(vb)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (b *Base64URL) DeepCopyInto(out *Base64URL) {
+	*out = *b
+}
+
+// DeepCopy copies the receiver into a new Base64URL.
+func (b *Base64URL) DeepCopy() *Base64URL {
+	if b == nil {
+		return nil
+	}
+	out := new(Base64URL)
+	b.DeepCopyInto(out)
+	return out
+}
+
+// Bytes returns the decoded content of this Base64URL
+func (b Base64URL) Bytes() ([]byte, error) {
+	return []byte(b), nil
+}
+
+// Len returns the length of the decoded content of this Base64URL
+func (b Base64URL) Len() (int, error) {
+	return len(b), nil
+}
+
 // URI represents the uri string format as specified by the json schema spec
 //
 // swagger:strfmt uri
@@ -414,6 +878,21 @@ func (u *URI) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as uri")
 }
 
+// MarshalBSONValue marshals this URI as a BSON string
+func (u URI) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this URI from a BSON string
+func (u *URI) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "URI")
+	if err != nil {
+		return err
+	}
+	*u = URI(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *URI) DeepCopyInto(out *URI) {
 	*out = *u
@@ -502,6 +981,21 @@ func (e *Email) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as email")
 }
 
+// MarshalBSONValue marshals this Email as a BSON string
+func (e Email) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(e))
+}
+
+// UnmarshalBSONValue reads this Email from a BSON string
+func (e *Email) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "Email")
+	if err != nil {
+		return err
+	}
+	*e = Email(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (e *Email) DeepCopyInto(out *Email) {
 	*out = *e
@@ -590,6 +1084,21 @@ func (h *Hostname) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as hostname")
 }
 
+// MarshalBSONValue marshals this Hostname as a BSON string
+func (h Hostname) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(h))
+}
+
+// UnmarshalBSONValue reads this Hostname from a BSON string
+func (h *Hostname) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "Hostname")
+	if err != nil {
+		return err
+	}
+	*h = Hostname(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (h *Hostname) DeepCopyInto(out *Hostname) {
 	*out = *h
@@ -605,11 +1114,80 @@ func (h *Hostname) DeepCopy() *Hostname {
 	return out
 }
 
+// Labels returns the dot-separated labels of this hostname.
+//
+// A trailing dot (fully qualified domain name) is ignored.
+func (h Hostname) Labels() []string {
+	str := strings.TrimSuffix(string(h), ".")
+	if str == "" {
+		return nil
+	}
+	return strings.Split(str, ".")
+}
+
+// TLD returns the last label of this hostname, e.g. "com" for "www.example.com"
+func (h Hostname) TLD() string {
+	labels := h.Labels()
+	if len(labels) == 0 {
+		return ""
+	}
+	return labels[len(labels)-1]
+}
+
+// IsFQDN returns true when this hostname ends with a dot, denoting a fully qualified domain name
+func (h Hostname) IsFQDN() bool {
+	return strings.HasSuffix(string(h), ".")
+}
+
+// StripTrailingDot returns this hostname without its trailing dot, if any
+func (h Hostname) StripTrailingDot() Hostname {
+	return Hostname(strings.TrimSuffix(string(h), "."))
+}
+
+// AddTrailingDot returns this hostname as a fully qualified domain name, ending with a dot
+func (h Hostname) AddTrailingDot() Hostname {
+	if h.IsFQDN() {
+		return h
+	}
+	return h + "."
+}
+
+// IsIDN returns true when any label of this hostname contains non-ASCII characters
+// and therefore requires IDNA encoding.
+func (h Hostname) IsIDN() bool {
+	for _, r := range string(h) {
+		if r >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
+
+// ToASCII returns the Punycode-encoded (A-label) form of this hostname
+func (h Hostname) ToASCII() (string, error) {
+	return idna.ToASCII(string(h))
+}
+
+// ToUnicode returns the Unicode (U-label) form of this hostname, decoding any Punycode-encoded label
+func (h Hostname) ToUnicode() (string, error) {
+	return idna.ToUnicode(string(h))
+}
+
 // IPv4 represents an IP v4 address
 //
 // swagger:strfmt ipv4
 type IPv4 string
 
+// Well-known IPv4 addresses
+const (
+	// IPv4Loopback is the IPv4 loopback address
+	IPv4Loopback IPv4 = "127.0.0.1"
+	// IPv4Any is the IPv4 unspecified address, used to listen on all interfaces
+	IPv4Any IPv4 = "0.0.0.0"
+	// IPv4Broadcast is the IPv4 limited broadcast address
+	IPv4Broadcast IPv4 = "255.255.255.255"
+)
+
 // MarshalText turns this instance into text
 func (u IPv4) MarshalText() ([]byte, error) {
 	return []byte(string(u)), nil
@@ -678,6 +1256,21 @@ func (u *IPv4) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as ipv4")
 }
 
+// MarshalBSONValue marshals this IPv4 as a BSON string
+func (u IPv4) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this IPv4 from a BSON string
+func (u *IPv4) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "IPv4")
+	if err != nil {
+		return err
+	}
+	*u = IPv4(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *IPv4) DeepCopyInto(out *IPv4) {
 	*out = *u
@@ -693,11 +1286,29 @@ func (u *IPv4) DeepCopy() *IPv4 {
 	return out
 }
 
+// Equal returns true when both addresses are the same IP address, as per net.IP.Equal.
+//
+// Differences of representation are ignored, e.g. "::ffff:127.0.0.1" equals "127.0.0.1".
+// Invalid addresses are never equal.
+func (u IPv4) Equal(other IPv4) bool {
+	return equalIPs(string(u), string(other))
+}
+
 // IPv6 represents an IP v6 address
 //
 // swagger:strfmt ipv6
 type IPv6 string
 
+// Well-known IPv6 addresses
+const (
+	// IPv6Loopback is the IPv6 loopback address
+	IPv6Loopback IPv6 = "::1"
+	// IPv6Any is the IPv6 unspecified address, used to listen on all interfaces
+	IPv6Any IPv6 = "::"
+	// IPv6LocalLinkAll is the link-local all-nodes multicast address
+	IPv6LocalLinkAll IPv6 = "ff02::1"
+)
+
 // MarshalText turns this instance into text
 func (u IPv6) MarshalText() ([]byte, error) {
 	return []byte(string(u)), nil
@@ -766,6 +1377,21 @@ func (u *IPv6) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as ipv6")
 }
 
+// MarshalBSONValue marshals this IPv6 as a BSON string
+func (u IPv6) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this IPv6 from a BSON string
+func (u *IPv6) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "IPv6")
+	if err != nil {
+		return err
+	}
+	*u = IPv6(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *IPv6) DeepCopyInto(out *IPv6) {
 	*out = *u
@@ -781,6 +1407,20 @@ func (u *IPv6) DeepCopy() *IPv6 {
 	return out
 }
 
+// Equal returns true when both addresses are the same IP address, as per net.IP.Equal.
+//
+// Differences of representation are ignored, e.g. "2001:db8::1" equals "2001:0DB8:0:0::1",
+// and "::ffff:127.0.0.1" equals "127.0.0.1". Invalid addresses are never equal.
+func (u IPv6) Equal(other IPv6) bool {
+	return equalIPs(string(u), string(other))
+}
+
+// equalIPs returns true when both strings are valid representations of the same IP address
+func equalIPs(a, b string) bool {
+	ipa, ipb := net.ParseIP(a), net.ParseIP(b)
+	return ipa != nil && ipb != nil && ipa.Equal(ipb)
+}
+
 // CIDR represents a Classless Inter-Domain Routing notation
 //
 // swagger:strfmt cidr
@@ -854,6 +1494,21 @@ func (u *CIDR) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as CIDR")
 }
 
+// MarshalBSONValue marshals this CIDR as a BSON string
+func (u CIDR) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this CIDR from a BSON string
+func (u *CIDR) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "CIDR")
+	if err != nil {
+		return err
+	}
+	*u = CIDR(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *CIDR) DeepCopyInto(out *CIDR) {
 	*out = *u
@@ -942,6 +1597,21 @@ func (u *MAC) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as MAC")
 }
 
+// MarshalBSONValue marshals this MAC as a BSON string
+func (u MAC) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this MAC from a BSON string
+func (u *MAC) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "MAC")
+	if err != nil {
+		return err
+	}
+	*u = MAC(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *MAC) DeepCopyInto(out *MAC) {
 	*out = *u
@@ -962,6 +1632,14 @@ func (u *MAC) DeepCopy() *MAC {
 // swagger:strfmt uuid
 type UUID string
 
+// Well-known namespaces for name-based UUIDs, as defined by RFC 4122
+const (
+	NameSpaceDNS  UUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	NameSpaceURL  UUID = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	NameSpaceOID  UUID = "6ba7b812-9dad-11d1-80b4-00c04fd430c8"
+	NameSpaceX500 UUID = "6ba7b814-9dad-11d1-80b4-00c04fd430c8"
+)
+
 // MarshalText turns this instance into text
 func (u UUID) MarshalText() ([]byte, error) {
 	return []byte(string(u)), nil
@@ -973,10 +1651,18 @@ func (u *UUID) UnmarshalText(data []byte) error { // validation is performed lat
 	return nil
 }
 
-// Scan read a value from a database driver
+// Scan read a value from a database driver.
+//
+// Raw UUIDs are accepted as [16]byte values, or as 16 bytes long []byte values.
 func (u *UUID) Scan(raw interface{}) error {
 	switch v := raw.(type) {
+	case [16]byte:
+		*u = UUIDFromBytes(v)
 	case []byte:
+		if len(v) == uuidBinarySize {
+			*u = UUIDFromBytes([16]byte(v))
+			break
+		}
 		*u = UUID(string(v))
 	case string:
 		*u = UUID(v)
@@ -1033,6 +1719,21 @@ func (u *UUID) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as UUID")
 }
 
+// MarshalBSONValue marshals this UUID as a BSON string
+func (u UUID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this UUID from a BSON string
+func (u *UUID) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "UUID")
+	if err != nil {
+		return err
+	}
+	*u = UUID(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *UUID) DeepCopyInto(out *UUID) {
 	*out = *u
@@ -1064,10 +1765,18 @@ func (u *UUID3) UnmarshalText(data []byte) error { // validation is performed la
 	return nil
 }
 
-// Scan read a value from a database driver
+// Scan read a value from a database driver.
+//
+// Raw UUIDs are accepted as [16]byte values, or as 16 bytes long []byte values.
 func (u *UUID3) Scan(raw interface{}) error {
 	switch v := raw.(type) {
+	case [16]byte:
+		*u = UUID3FromBytes(v)
 	case []byte:
+		if len(v) == uuidBinarySize {
+			*u = UUID3FromBytes([16]byte(v))
+			break
+		}
 		*u = UUID3(string(v))
 	case string:
 		*u = UUID3(v)
@@ -1124,6 +1833,21 @@ func (u *UUID3) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as UUID3")
 }
 
+// MarshalBSONValue marshals this UUID3 as a BSON string
+func (u UUID3) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this UUID3 from a BSON string
+func (u *UUID3) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "UUID3")
+	if err != nil {
+		return err
+	}
+	*u = UUID3(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *UUID3) DeepCopyInto(out *UUID3) {
 	*out = *u
@@ -1139,6 +1863,13 @@ func (u *UUID3) DeepCopy() *UUID3 {
 	return out
 }
 
+// NewUUID3 generates the version 3 (MD5 based) UUID of a name in a namespace, e.g. NameSpaceDNS.
+//
+// It panics if the namespace is not a valid UUID.
+func NewUUID3(namespace UUID, name string) UUID3 {
+	return UUID3(uuid.NewMD5(uuid.MustParse(string(namespace)), []byte(name)).String())
+}
+
 // UUID4 represents a uuid4 string format
 //
 // swagger:strfmt uuid4
@@ -1155,10 +1886,18 @@ func (u *UUID4) UnmarshalText(data []byte) error { // validation is performed la
 	return nil
 }
 
-// Scan read a value from a database driver
+// Scan read a value from a database driver.
+//
+// Raw UUIDs are accepted as [16]byte values, or as 16 bytes long []byte values.
 func (u *UUID4) Scan(raw interface{}) error {
 	switch v := raw.(type) {
+	case [16]byte:
+		*u = UUID4FromBytes(v)
 	case []byte:
+		if len(v) == uuidBinarySize {
+			*u = UUID4FromBytes([16]byte(v))
+			break
+		}
 		*u = UUID4(string(v))
 	case string:
 		*u = UUID4(v)
@@ -1192,90 +1931,379 @@ func (u *UUID4) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &ustr); err != nil {
 		return err
 	}
-	*u = UUID4(ustr)
+	*u = UUID4(ustr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (u UUID4) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": u.String()})
+}
+
+// UnmarshalBSON document into this value
+func (u *UUID4) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*u = UUID4(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as UUID4")
+}
+
+// MarshalBSONValue marshals this UUID4 as a BSON string
+func (u UUID4) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this UUID4 from a BSON string
+func (u *UUID4) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "UUID4")
+	if err != nil {
+		return err
+	}
+	*u = UUID4(str)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (u *UUID4) DeepCopyInto(out *UUID4) {
+	*out = *u
+}
+
+// DeepCopy copies the receiver into a new UUID4.
+func (u *UUID4) DeepCopy() *UUID4 {
+	if u == nil {
+		return nil
+	}
+	out := new(UUID4)
+	u.DeepCopyInto(out)
+	return out
+}
+
+// NewUUID5 generates the version 5 (SHA1 based) UUID of a name in a namespace, e.g. NameSpaceDNS.
+//
+// It panics if the namespace is not a valid UUID.
+func NewUUID5(namespace UUID, name string) UUID5 {
+	return UUID5(uuid.NewSHA1(uuid.MustParse(string(namespace)), []byte(name)).String())
+}
+
+// UUID5 represents a uuid5 string format
+//
+// swagger:strfmt uuid5
+type UUID5 string
+
+// MarshalText turns this instance into text
+func (u UUID5) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID5) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID5(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver.
+//
+// Raw UUIDs are accepted as [16]byte values, or as 16 bytes long []byte values.
+func (u *UUID5) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case [16]byte:
+		*u = UUID5FromBytes(v)
+	case []byte:
+		if len(v) == uuidBinarySize {
+			*u = UUID5FromBytes([16]byte(v))
+			break
+		}
+		*u = UUID5(string(v))
+	case string:
+		*u = UUID5(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID5 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID5) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID5) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID as JSON
+func (u UUID5) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(u))
+}
+
+// UnmarshalJSON sets the UUID from JSON
+func (u *UUID5) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var ustr string
+	if err := json.Unmarshal(data, &ustr); err != nil {
+		return err
+	}
+	*u = UUID5(ustr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (u UUID5) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": u.String()})
+}
+
+// UnmarshalBSON document into this value
+func (u *UUID5) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*u = UUID5(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as UUID5")
+}
+
+// MarshalBSONValue marshals this UUID5 as a BSON string
+func (u UUID5) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this UUID5 from a BSON string
+func (u *UUID5) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "UUID5")
+	if err != nil {
+		return err
+	}
+	*u = UUID5(str)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (u *UUID5) DeepCopyInto(out *UUID5) {
+	*out = *u
+}
+
+// DeepCopy copies the receiver into a new UUID5.
+func (u *UUID5) DeepCopy() *UUID5 {
+	if u == nil {
+		return nil
+	}
+	out := new(UUID5)
+	u.DeepCopyInto(out)
+	return out
+}
+
+// NewUUID6 generates a new version 6 (time ordered, field compatible with version 1) UUID,
+// with a random node as recommended by RFC 9562.
+func NewUUID6() (UUID6, error) {
+	now, seq, err := uuid.GetTime()
+	if err != nil {
+		return "", err
+	}
+
+	var id uuid.UUID
+	ts := uint64(now)
+	binary.BigEndian.PutUint32(id[0:], uint32(ts>>28))
+	binary.BigEndian.PutUint16(id[4:], uint16(ts>>12))
+	binary.BigEndian.PutUint16(id[6:], 0x6000|uint16(ts&0x0fff))
+	binary.BigEndian.PutUint16(id[8:], 0x8000|seq&0x3fff)
+	if _, err := io.ReadFull(rand.Reader, id[10:]); err != nil {
+		return "", err
+	}
+	return UUID6(id.String()), nil
+}
+
+// UUID6 represents a uuid6 string format
+//
+// swagger:strfmt uuid6
+type UUID6 string
+
+// MarshalText turns this instance into text
+func (u UUID6) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID6) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID6(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver.
+//
+// Raw UUIDs are accepted as [16]byte values, or as 16 bytes long []byte values.
+func (u *UUID6) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case [16]byte:
+		*u = UUID6FromBytes(v)
+	case []byte:
+		if len(v) == uuidBinarySize {
+			*u = UUID6FromBytes([16]byte(v))
+			break
+		}
+		*u = UUID6(string(v))
+	case string:
+		*u = UUID6(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID6 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID6) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID6) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID as JSON
+func (u UUID6) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(u))
+}
+
+// UnmarshalJSON sets the UUID from JSON
+func (u *UUID6) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var ustr string
+	if err := json.Unmarshal(data, &ustr); err != nil {
+		return err
+	}
+	*u = UUID6(ustr)
 	return nil
 }
 
 // MarshalBSON document from this value
-func (u UUID4) MarshalBSON() ([]byte, error) {
+func (u UUID6) MarshalBSON() ([]byte, error) {
 	return bson.Marshal(bson.M{"data": u.String()})
 }
 
 // UnmarshalBSON document into this value
-func (u *UUID4) UnmarshalBSON(data []byte) error {
+func (u *UUID6) UnmarshalBSON(data []byte) error {
 	var m bson.M
 	if err := bson.Unmarshal(data, &m); err != nil {
 		return err
 	}
 
 	if ud, ok := m["data"].(string); ok {
-		*u = UUID4(ud)
+		*u = UUID6(ud)
 		return nil
 	}
-	return errors.New("couldn't unmarshal bson bytes as UUID4")
+	return errors.New("couldn't unmarshal bson bytes as UUID6")
+}
+
+// MarshalBSONValue marshals this UUID6 as a BSON string
+func (u UUID6) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this UUID6 from a BSON string
+func (u *UUID6) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "UUID6")
+	if err != nil {
+		return err
+	}
+	*u = UUID6(str)
+	return nil
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
-func (u *UUID4) DeepCopyInto(out *UUID4) {
+func (u *UUID6) DeepCopyInto(out *UUID6) {
 	*out = *u
 }
 
-// DeepCopy copies the receiver into a new UUID4.
-func (u *UUID4) DeepCopy() *UUID4 {
+// DeepCopy copies the receiver into a new UUID6.
+func (u *UUID6) DeepCopy() *UUID6 {
 	if u == nil {
 		return nil
 	}
-	out := new(UUID4)
+	out := new(UUID6)
 	u.DeepCopyInto(out)
 	return out
 }
 
-// UUID5 represents a uuid5 string format
+// NewUUID7 generates a new version 7 (time ordered) UUID.
 //
-// swagger:strfmt uuid5
-type UUID5 string
+// See UUID7Generator to produce many UUIDs in a tight loop.
+func NewUUID7() (UUID7, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return UUID7(id.String()), nil
+}
+
+// UUID7 represents a uuid7 string format
+//
+// swagger:strfmt uuid7
+type UUID7 string
 
 // MarshalText turns this instance into text
-func (u UUID5) MarshalText() ([]byte, error) {
+func (u UUID7) MarshalText() ([]byte, error) {
 	return []byte(string(u)), nil
 }
 
 // UnmarshalText hydrates this instance from text
-func (u *UUID5) UnmarshalText(data []byte) error { // validation is performed later on
-	*u = UUID5(string(data))
+func (u *UUID7) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID7(string(data))
 	return nil
 }
 
-// Scan read a value from a database driver
-func (u *UUID5) Scan(raw interface{}) error {
+// Scan read a value from a database driver.
+//
+// Raw UUIDs are accepted as [16]byte values, or as 16 bytes long []byte values.
+func (u *UUID7) Scan(raw interface{}) error {
 	switch v := raw.(type) {
+	case [16]byte:
+		*u = UUID7FromBytes(v)
 	case []byte:
-		*u = UUID5(string(v))
+		if len(v) == uuidBinarySize {
+			*u = UUID7FromBytes([16]byte(v))
+			break
+		}
+		*u = UUID7(string(v))
 	case string:
-		*u = UUID5(v)
+		*u = UUID7(v)
 	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.UUID5 from: %#v", v)
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID7 from: %#v", v)
 	}
 
 	return nil
 }
 
 // Value converts a value to a database driver value
-func (u UUID5) Value() (driver.Value, error) {
+func (u UUID7) Value() (driver.Value, error) {
 	return driver.Value(string(u)), nil
 }
 
-func (u UUID5) String() string {
+func (u UUID7) String() string {
 	return string(u)
 }
 
 // MarshalJSON returns the UUID as JSON
-func (u UUID5) MarshalJSON() ([]byte, error) {
+func (u UUID7) MarshalJSON() ([]byte, error) {
 	return json.Marshal(string(u))
 }
 
 // UnmarshalJSON sets the UUID from JSON
-func (u *UUID5) UnmarshalJSON(data []byte) error {
+func (u *UUID7) UnmarshalJSON(data []byte) error {
 	if string(data) == jsonNull {
 		return nil
 	}
@@ -1283,40 +2311,55 @@ func (u *UUID5) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &ustr); err != nil {
 		return err
 	}
-	*u = UUID5(ustr)
+	*u = UUID7(ustr)
 	return nil
 }
 
 // MarshalBSON document from this value
-func (u UUID5) MarshalBSON() ([]byte, error) {
+func (u UUID7) MarshalBSON() ([]byte, error) {
 	return bson.Marshal(bson.M{"data": u.String()})
 }
 
 // UnmarshalBSON document into this value
-func (u *UUID5) UnmarshalBSON(data []byte) error {
+func (u *UUID7) UnmarshalBSON(data []byte) error {
 	var m bson.M
 	if err := bson.Unmarshal(data, &m); err != nil {
 		return err
 	}
 
 	if ud, ok := m["data"].(string); ok {
-		*u = UUID5(ud)
+		*u = UUID7(ud)
 		return nil
 	}
-	return errors.New("couldn't unmarshal bson bytes as UUID5")
+	return errors.New("couldn't unmarshal bson bytes as UUID7")
+}
+
+// MarshalBSONValue marshals this UUID7 as a BSON string
+func (u UUID7) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this UUID7 from a BSON string
+func (u *UUID7) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "UUID7")
+	if err != nil {
+		return err
+	}
+	*u = UUID7(str)
+	return nil
 }
 
 // DeepCopyInto copies the receiver and writes its value into out.
-func (u *UUID5) DeepCopyInto(out *UUID5) {
+func (u *UUID7) DeepCopyInto(out *UUID7) {
 	*out = *u
 }
 
-// DeepCopy copies the receiver into a new UUID5.
-func (u *UUID5) DeepCopy() *UUID5 {
+// DeepCopy copies the receiver into a new UUID7.
+func (u *UUID7) DeepCopy() *UUID7 {
 	if u == nil {
 		return nil
 	}
-	out := new(UUID5)
+	out := new(UUID7)
 	u.DeepCopyInto(out)
 	return out
 }
@@ -1397,6 +2440,21 @@ func (u *ISBN) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as ISBN")
 }
 
+// MarshalBSONValue marshals this ISBN as a BSON string
+func (u ISBN) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this ISBN from a BSON string
+func (u *ISBN) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "ISBN")
+	if err != nil {
+		return err
+	}
+	*u = ISBN(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *ISBN) DeepCopyInto(out *ISBN) {
 	*out = *u
@@ -1488,6 +2546,21 @@ func (u *ISBN10) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as ISBN10")
 }
 
+// MarshalBSONValue marshals this ISBN10 as a BSON string
+func (u ISBN10) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this ISBN10 from a BSON string
+func (u *ISBN10) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "ISBN10")
+	if err != nil {
+		return err
+	}
+	*u = ISBN10(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *ISBN10) DeepCopyInto(out *ISBN10) {
 	*out = *u
@@ -1579,6 +2652,21 @@ func (u *ISBN13) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as ISBN13")
 }
 
+// MarshalBSONValue marshals this ISBN13 as a BSON string
+func (u ISBN13) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this ISBN13 from a BSON string
+func (u *ISBN13) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "ISBN13")
+	if err != nil {
+		return err
+	}
+	*u = ISBN13(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *ISBN13) DeepCopyInto(out *ISBN13) {
 	*out = *u
@@ -1594,6 +2682,120 @@ func (u *ISBN13) DeepCopy() *ISBN13 {
 	return out
 }
 
+// isbnSeparators strips the separators allowed in ISBN strings
+var isbnSeparators = strings.NewReplacer("-", "", " ", "")
+
+// LuhnISBN10 returns true when the string has a valid ISBN-10 check digit (weighted mod 11 checksum).
+//
+// Hyphens and spaces are ignored. The check digit may be 'X', standing for 10.
+func LuhnISBN10(s string) bool {
+	digits := isbnSeparators.Replace(s)
+	if len(digits) != 10 {
+		return false
+	}
+
+	var sum int
+	for i := 0; i < 10; i++ {
+		c := digits[i]
+		var d int
+		switch {
+		case c >= '0' && c <= '9':
+			d = int(c - '0')
+		case i == 9 && (c == 'X' || c == 'x'):
+			d = 10
+		default:
+			return false
+		}
+		sum += (10 - i) * d
+	}
+	return sum%11 == 0
+}
+
+// EAN13CheckDigit computes the EAN-13 check digit for the first 12 digits of an ISBN-13.
+//
+// Hyphens and spaces are ignored. The check digit is returned as an ASCII character ('0' to '9').
+func EAN13CheckDigit(s string) (byte, error) {
+	digits := isbnSeparators.Replace(s)
+	if len(digits) != 12 {
+		return 0, fmt.Errorf("expected 12 digits to compute an EAN-13 check digit, got %q", s)
+	}
+
+	var sum int
+	for i := 0; i < 12; i++ {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid digit %q in %q", c, s)
+		}
+		d := int(c - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10), nil
+}
+
+// isbn10CheckDigit computes the ISBN-10 check digit for the first 9 digits of an ISBN-10
+func isbn10CheckDigit(digits string) byte {
+	var sum int
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(digits[i]-'0')
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+// Normalize strips separators from this ISBN and converts it to the ISBN-13 form.
+//
+// The stripped ISBN is returned as is when it is not a valid ISBN-10.
+func (u ISBN) Normalize() ISBN {
+	digits := isbnSeparators.Replace(string(u))
+	if len(digits) != 10 {
+		return ISBN(digits)
+	}
+	isbn13, err := ISBN10(digits).ToISBN13()
+	if err != nil {
+		return ISBN(digits)
+	}
+	return ISBN(isbn13)
+}
+
+// ToISBN13 converts this ISBN-10 to its ISBN-13 equivalent, with the "978" prefix.
+//
+// The returned ISBN-13 has no separators.
+func (u ISBN10) ToISBN13() (ISBN13, error) {
+	if !LuhnISBN10(string(u)) {
+		return "", fmt.Errorf("invalid ISBN-10: %q", string(u))
+	}
+	digits := "978" + isbnSeparators.Replace(string(u))[:9]
+	check, err := EAN13CheckDigit(digits)
+	if err != nil {
+		return "", err
+	}
+	return ISBN13(digits + string(check)), nil
+}
+
+// ToISBN10 converts this ISBN-13 to its ISBN-10 equivalent.
+//
+// Only ISBN-13 with the "978" prefix have an ISBN-10 equivalent. The returned ISBN-10 has no separators.
+func (u ISBN13) ToISBN10() (ISBN10, error) {
+	digits := isbnSeparators.Replace(string(u))
+	if len(digits) != 13 {
+		return "", fmt.Errorf("invalid ISBN-13: %q", string(u))
+	}
+	check, err := EAN13CheckDigit(digits[:12])
+	if err != nil || check != digits[12] {
+		return "", fmt.Errorf("invalid ISBN-13: %q", string(u))
+	}
+	if !strings.HasPrefix(digits, "978") {
+		return "", fmt.Errorf("ISBN-13 %q has no ISBN-10 equivalent: only the 978 prefix may be converted", string(u))
+	}
+	return ISBN10(digits[3:12] + string(isbn10CheckDigit(digits[3:12]))), nil
+}
+
 // CreditCard represents a credit card string format
 //
 // swagger:strfmt creditcard
@@ -1670,6 +2872,21 @@ func (u *CreditCard) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as CreditCard")
 }
 
+// MarshalBSONValue marshals this CreditCard as a BSON string
+func (u CreditCard) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this CreditCard from a BSON string
+func (u *CreditCard) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "CreditCard")
+	if err != nil {
+		return err
+	}
+	*u = CreditCard(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *CreditCard) DeepCopyInto(out *CreditCard) {
 	*out = *u
@@ -1685,11 +2902,154 @@ func (u *CreditCard) DeepCopy() *CreditCard {
 	return out
 }
 
+// Digits returns only the numeric digits of this credit card number, e.g. without dashes or spaces
+func (u CreditCard) Digits() string {
+	var b strings.Builder
+	b.Grow(len(u))
+	for _, r := range string(u) {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Network returns the card network of this credit card number, based on its issuer identification number (IIN).
+//
+// The returned value is one of "Visa", "Mastercard", "Amex", "Discover", "UnionPay" or "Unknown".
+func (u CreditCard) Network() string {
+	digits := u.Digits()
+	prefix := func(n int) int {
+		if len(digits) < n {
+			return -1
+		}
+		p, _ := strconv.Atoi(digits[:n])
+		return p
+	}
+
+	switch {
+	case prefix(1) == 4:
+		return "Visa"
+	case prefix(2) >= 51 && prefix(2) <= 55, prefix(4) >= 2221 && prefix(4) <= 2720:
+		return "Mastercard"
+	case prefix(2) == 34, prefix(2) == 37:
+		return "Amex"
+	case prefix(4) == 6011, prefix(3) >= 644 && prefix(3) <= 649, prefix(2) == 65:
+		return "Discover"
+	case prefix(2) == 62:
+		return "UnionPay"
+	default:
+		return "Unknown"
+	}
+}
+
+// Mask returns this credit card number with all but the last 4 digits replaced by '*'.
+//
+// Separators are preserved, e.g. "4111-1111-1111-1111" is masked as "****-****-****-1111".
+func (u CreditCard) Mask() string {
+	keep := 4
+	masked := []rune(string(u))
+	for i := len(masked) - 1; i >= 0; i-- {
+		if masked[i] < '0' || masked[i] > '9' {
+			continue
+		}
+		if keep > 0 {
+			keep--
+			continue
+		}
+		masked[i] = '*'
+	}
+	return string(masked)
+}
+
+// LuhnCheck returns true when the number passes the Luhn (mod 10) checksum.
+//
+// The number must only contain digits.
+func LuhnCheck(number string) bool {
+	if number == "" {
+		return false
+	}
+
+	var sum int
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
 // SSN represents a social security string format
 //
 // swagger:strfmt ssn
 type SSN string
 
+// digits returns the 9 digits of this SSN, or an empty string if it is not a social security number
+func (u SSN) digits() string {
+	if !IsSSN(string(u)) {
+		return ""
+	}
+	return strings.NewReplacer("-", "", " ", "").Replace(string(u))
+}
+
+// Area returns the first 3 digits (area number) of this SSN, or an empty string if it is not a social security number
+func (u SSN) Area() string {
+	if d := u.digits(); d != "" {
+		return d[:3]
+	}
+	return ""
+}
+
+// Group returns the middle 2 digits (group number) of this SSN, or an empty string if it is not a social security number
+func (u SSN) Group() string {
+	if d := u.digits(); d != "" {
+		return d[3:5]
+	}
+	return ""
+}
+
+// Serial returns the last 4 digits (serial number) of this SSN, or an empty string if it is not a social security number
+func (u SSN) Serial() string {
+	if d := u.digits(); d != "" {
+		return d[5:]
+	}
+	return ""
+}
+
+// IsValid returns true when this SSN has a valid format and is not in a range excluded by the SSA rules.
+//
+// The following are never assigned: area 000, 666 or 800-999, group 00 and serial 0000.
+func (u SSN) IsValid() bool {
+	d := u.digits()
+	if d == "" {
+		return false
+	}
+	area, group, serial := d[:3], d[3:5], d[5:]
+	return area != "000" && area != "666" && area < "800" && group != "00" && serial != "0000"
+}
+
+// Mask returns this SSN with all but the serial number replaced by '*', e.g. "***-**-1234".
+//
+// An SSN with an invalid format is entirely masked.
+func (u SSN) Mask() string {
+	serial := u.Serial()
+	if serial == "" {
+		serial = "****"
+	}
+	return "***-**-" + serial
+}
+
 // MarshalText turns this instance into text
 func (u SSN) MarshalText() ([]byte, error) {
 	return []byte(string(u)), nil
@@ -1761,6 +3121,21 @@ func (u *SSN) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as SSN")
 }
 
+// MarshalBSONValue marshals this SSN as a BSON string
+func (u SSN) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(u))
+}
+
+// UnmarshalBSONValue reads this SSN from a BSON string
+func (u *SSN) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "SSN")
+	if err != nil {
+		return err
+	}
+	*u = SSN(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (u *SSN) DeepCopyInto(out *SSN) {
 	*out = *u
@@ -1852,6 +3227,21 @@ func (h *HexColor) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as HexColor")
 }
 
+// MarshalBSONValue marshals this HexColor as a BSON string
+func (h HexColor) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(h))
+}
+
+// UnmarshalBSONValue reads this HexColor from a BSON string
+func (h *HexColor) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "HexColor")
+	if err != nil {
+		return err
+	}
+	*h = HexColor(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (h *HexColor) DeepCopyInto(out *HexColor) {
 	*out = *h
@@ -1943,6 +3333,21 @@ func (r *RGBColor) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as RGBColor")
 }
 
+// MarshalBSONValue marshals this RGBColor as a BSON string
+func (r RGBColor) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(r))
+}
+
+// UnmarshalBSONValue reads this RGBColor from a BSON string
+func (r *RGBColor) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "RGBColor")
+	if err != nil {
+		return err
+	}
+	*r = RGBColor(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (r *RGBColor) DeepCopyInto(out *RGBColor) {
 	*out = *r
@@ -1958,9 +3363,21 @@ func (r *RGBColor) DeepCopy() *RGBColor {
 	return out
 }
 
+// PasswordRedaction, when enabled, causes Password.MarshalJSON to render "[REDACTED]" instead of the actual password.
+//
+// MarshalText and String are not affected and always render the actual password,
+// whereas the fmt package always formats a redacted password.
+var PasswordRedaction = false
+
+// redactedPassword is the JSON rendering of a redacted password
+const redactedPassword = "[REDACTED]"
+
 // Password represents a password.
 // This has no validations and is mainly used as a marker for UI components.
 //
+// To avoid leaking secrets in logs and error messages, a Password is formatted as "[REDACTED]"
+// by the fmt package. Like for other formats, String returns the actual password, as used by encoders.
+//
 // swagger:strfmt password
 type Password string
 
@@ -1994,15 +3411,52 @@ func (r Password) Value() (driver.Value, error) {
 	return driver.Value(string(r)), nil
 }
 
+// Redact returns "[REDACTED]", regardless of the actual password
+func (r Password) Redact() string {
+	return redactedPassword
+}
+
+// String returns the actual password, e.g. to be sent as a request parameter
 func (r Password) String() string {
 	return string(r)
 }
 
-// MarshalJSON returns the Password as JSON
+// GoString returns a redacted Go representation of the password, for the %#v verb of the fmt package
+func (r Password) GoString() string {
+	return `strfmt.Password("` + r.Redact() + `")`
+}
+
+// Format formats the redacted password (see Redact) for the fmt package, e.g. with the %v and %s verbs.
+//
+// The %#v verb formats the Go representation of the password returned by GoString.
+func (r Password) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('#') {
+		_, _ = io.WriteString(s, r.GoString())
+		return
+	}
+	_, _ = fmt.Fprintf(s, fmt.FormatString(s, verb), r.Redact())
+}
+
+// Plaintext returns the actual password
+func (r Password) Plaintext() string {
+	return string(r)
+}
+
+// MarshalJSON returns the Password as JSON.
+//
+// The password is redacted when PasswordRedaction is enabled.
 func (r Password) MarshalJSON() ([]byte, error) {
+	if PasswordRedaction {
+		return r.RedactedJSON()
+	}
 	return json.Marshal(string(r))
 }
 
+// RedactedJSON returns the Password as redacted JSON, i.e. "[REDACTED]", regardless of PasswordRedaction
+func (r Password) RedactedJSON() ([]byte, error) {
+	return json.Marshal(r.Redact())
+}
+
 // UnmarshalJSON sets the Password from JSON
 func (r *Password) UnmarshalJSON(data []byte) error {
 	if string(data) == jsonNull {
@@ -2018,7 +3472,7 @@ func (r *Password) UnmarshalJSON(data []byte) error {
 
 // MarshalBSON document from this value
 func (r Password) MarshalBSON() ([]byte, error) {
-	return bson.Marshal(bson.M{"data": r.String()})
+	return bson.Marshal(bson.M{"data": r.Plaintext()})
 }
 
 // UnmarshalBSON document into this value
@@ -2035,6 +3489,21 @@ func (r *Password) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes as Password")
 }
 
+// MarshalBSONValue marshals this Password as a BSON string
+func (r Password) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(r))
+}
+
+// UnmarshalBSONValue reads this Password from a BSON string
+func (r *Password) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "Password")
+	if err != nil {
+		return err
+	}
+	*r = Password(str)
+	return nil
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (r *Password) DeepCopyInto(out *Password) {
 	*out = *r
@@ -2049,3 +3518,105 @@ func (r *Password) DeepCopy() *Password {
 	r.DeepCopyInto(out)
 	return out
 }
+
+// Entropy estimates the entropy of this password, in bits.
+//
+// The estimate is based on the length of the password and the size of the pool
+// of characters implied by the character classes in use (lowercase, uppercase, digits, others).
+func (r Password) Entropy() float64 {
+	hasLower, hasUpper, hasDigit, hasSpecial := r.charClasses()
+
+	var pool int
+	if hasLower {
+		pool += 26
+	}
+	if hasUpper {
+		pool += 26
+	}
+	if hasDigit {
+		pool += 10
+	}
+	if hasSpecial {
+		pool += 33
+	}
+	if pool == 0 {
+		return 0
+	}
+
+	return float64(utf8.RuneCountInString(string(r))) * math.Log2(float64(pool))
+}
+
+// charClasses tells which classes of characters are used in this password
+func (r Password) charClasses() (hasLower, hasUpper, hasDigit, hasSpecial bool) {
+	for _, c := range string(r) {
+		switch {
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		default:
+			hasSpecial = true
+		}
+	}
+	return
+}
+
+// BreachChecker knows how to tell if a password is known to have been exposed in a data breach
+// (e.g. using a k-anonymity query against the "Have I Been Pwned" API).
+type BreachChecker interface {
+	IsBreached(password string) (bool, error)
+}
+
+// IsBreached checks this password against a BreachChecker
+func (r Password) IsBreached(checker BreachChecker) (bool, error) {
+	if checker == nil {
+		return false, errors.New("a breach checker is required to check a Password")
+	}
+	return checker.IsBreached(string(r))
+}
+
+// PasswordPolicy describes the quality rules a Password must abide by.
+//
+// Zero values disable the corresponding rule.
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	MinEntropy       float64
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSpecial   bool
+}
+
+// Validate checks a Password against this policy and returns one error for each rule that is not satisfied
+func (p PasswordPolicy) Validate(pw Password) []error {
+	hasLower, hasUpper, hasDigit, hasSpecial := pw.charClasses()
+
+	var errs []error
+	length := utf8.RuneCountInString(string(pw))
+	if p.MinLength > 0 && length < p.MinLength {
+		errs = append(errs, fmt.Errorf("password must be at least %d characters long", p.MinLength))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		errs = append(errs, fmt.Errorf("password must be at most %d characters long", p.MaxLength))
+	}
+	if p.MinEntropy > 0 && pw.Entropy() < p.MinEntropy {
+		errs = append(errs, fmt.Errorf("password must have an entropy of at least %.0f bits", p.MinEntropy))
+	}
+	if p.RequireUppercase && !hasUpper {
+		errs = append(errs, errors.New("password must contain an uppercase letter"))
+	}
+	if p.RequireLowercase && !hasLower {
+		errs = append(errs, errors.New("password must contain a lowercase letter"))
+	}
+	if p.RequireDigit && !hasDigit {
+		errs = append(errs, errors.New("password must contain a digit"))
+	}
+	if p.RequireSpecial && !hasSpecial {
+		errs = append(errs, errors.New("password must contain a special character"))
+	}
+
+	return errs
+}
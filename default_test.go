@@ -20,13 +20,17 @@ import (
 	"encoding"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"regexp"
 	"strings"
 	"testing"
+	"testing/iotest"
 
+	"github.com/asaskevich/govalidator"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -97,9 +101,14 @@ func TestFormatHostname(t *testing.T) {
 		"a.b.c.d",
 		"-xyz",
 		"xyz-",
-		"x.",
 		"a.b.c.dot-",
 		"a.b.c.é;ö",
+		// trailing dots
+		".",
+		"..",
+		"x..",
+		"www.example.org..",
+		".www.example.org",
 	}
 	validHostnames := []string{
 		"somewhere.com",
@@ -134,6 +143,11 @@ func TestFormatHostname(t *testing.T) {
 		"a.b.c.dot",
 		"www.example.org",
 		"a.b.c.d.e.f.g.dot",
+		// fully qualified domain names
+		"x.",
+		"foo.bar.",
+		"www.example.org.",
+		"xn--bcher-kva.example.com.",
 		// extended symbol alphabet
 		"ex=ample.com",
 		"<foo>",
@@ -149,6 +163,56 @@ func TestFormatHostname(t *testing.T) {
 	testStringFormat(t, &hostname, "hostname", str, validHostnames, []string{})
 }
 
+func TestHostname_Labels(t *testing.T) {
+	assert.Equal(t, []string{"www", "example", "com"}, Hostname("www.example.com").Labels())
+	assert.Equal(t, []string{"www", "example", "com"}, Hostname("www.example.com.").Labels())
+	assert.Equal(t, []string{"localhost"}, Hostname("localhost").Labels())
+	assert.Nil(t, Hostname("").Labels())
+
+	assert.Equal(t, "com", Hostname("www.example.com").TLD())
+	assert.Equal(t, "org", Hostname("example.org.").TLD())
+	assert.Equal(t, "localhost", Hostname("localhost").TLD())
+	assert.Empty(t, Hostname("").TLD())
+}
+
+func TestHostname_FQDN(t *testing.T) {
+	assert.True(t, Hostname("www.example.com.").IsFQDN())
+	assert.False(t, Hostname("www.example.com").IsFQDN())
+	assert.False(t, Hostname("").IsFQDN())
+
+	assert.Equal(t, Hostname("www.example.com"), Hostname("www.example.com.").StripTrailingDot())
+	assert.Equal(t, Hostname("www.example.com"), Hostname("www.example.com").StripTrailingDot())
+
+	assert.Equal(t, Hostname("www.example.com."), Hostname("www.example.com").AddTrailingDot())
+	assert.Equal(t, Hostname("www.example.com."), Hostname("www.example.com.").AddTrailingDot())
+
+	for _, h := range []Hostname{"localhost", "www.example.com", "bücher.example.com"} {
+		assert.Truef(t, IsHostname(string(h.AddTrailingDot())), "expected %q to be valid", h.AddTrailingDot())
+		assert.Truef(t, IsIDNHostname(string(h.AddTrailingDot())), "expected %q to be a valid IDN hostname", h.AddTrailingDot())
+	}
+}
+
+func TestHostname_IDN(t *testing.T) {
+	assert.False(t, Hostname("www.example.com").IsIDN())
+	assert.False(t, Hostname("xn--bcher-kva.example.com").IsIDN())
+	assert.True(t, Hostname("bücher.example.com").IsIDN())
+
+	ascii, err := Hostname("bücher.example.com").ToASCII()
+	require.NoError(t, err)
+	assert.Equal(t, "xn--bcher-kva.example.com", ascii)
+
+	ascii, err = Hostname("www.example.com").ToASCII()
+	require.NoError(t, err)
+	assert.Equal(t, "www.example.com", ascii)
+
+	unicode, err := Hostname("xn--bcher-kva.example.com").ToUnicode()
+	require.NoError(t, err)
+	assert.Equal(t, "bücher.example.com", unicode)
+
+	_, err = Hostname("xn--99999999999.example.com").ToUnicode()
+	require.Error(t, err)
+}
+
 func TestFormatIPv4(t *testing.T) {
 	ipv4 := IPv4("192.168.254.1")
 	str := string("192.168.254.2")
@@ -162,6 +226,28 @@ func TestFormatIPv6(t *testing.T) {
 	testStringFormat(t, &ipv6, "ipv6", str, []string{}, []string{"127.0.0.1"})
 }
 
+func TestIP_WellKnownAddresses(t *testing.T) {
+	for _, ip := range []IPv4{IPv4Loopback, IPv4Any, IPv4Broadcast} {
+		testValid(t, "ipv4", string(ip))
+	}
+	for _, ip := range []IPv6{IPv6Loopback, IPv6Any, IPv6LocalLinkAll} {
+		testValid(t, "ipv6", string(ip))
+	}
+}
+
+func TestIP_Equal(t *testing.T) {
+	assert.True(t, IPv4Loopback.Equal("127.0.0.1"))
+	assert.True(t, IPv4Loopback.Equal("::ffff:127.0.0.1"))
+	assert.False(t, IPv4Loopback.Equal("127.0.0.2"))
+	assert.False(t, IPv4("not an ip").Equal("not an ip"))
+
+	assert.True(t, IPv6Loopback.Equal("0:0:0:0:0:0:0:1"))
+	assert.True(t, IPv6("2001:db8::1").Equal("2001:0DB8:0:0::1"))
+	assert.True(t, IPv6("::ffff:127.0.0.1").Equal("127.0.0.1"))
+	assert.False(t, IPv6Any.Equal(IPv6Loopback))
+	assert.False(t, IPv6("").Equal(""))
+}
+
 func TestFormatCIDR(t *testing.T) {
 	cidr := CIDR("192.168.254.1/24")
 	str := string("192.168.254.2/24")
@@ -267,6 +353,94 @@ func TestFormatUUID5(t *testing.T) {
 	assert.EqualValues(t, UUID5(""), uuidZero)
 }
 
+func TestFormatUUID6(t *testing.T) {
+	first6 := uuid.Must(uuid.NewV6())
+	other4 := uuid.Must(uuid.NewRandom())
+	other6 := uuid.Must(uuid.NewV6())
+	other7 := uuid.Must(uuid.NewV7())
+	uuid6 := UUID6(first6.String())
+	str := other6.String()
+	testStringFormat(t, &uuid6, "uuid6", str,
+		[]string{
+			other6.String(),
+			strings.ReplaceAll(other6.String(), "-", ""),
+		},
+		[]string{
+			"not-a-uuid",
+			other4.String(),
+			other7.String(),
+			strings.ReplaceAll(other4.String(), "-", ""),
+			strings.Replace(other6.String(), "-", "", 2),
+		},
+	)
+
+	// special case for zero UUID
+	var uuidZero UUID6
+	err := uuidZero.UnmarshalJSON([]byte(jsonNull))
+	require.NoError(t, err)
+	assert.EqualValues(t, UUID6(""), uuidZero)
+
+	u6, err := NewUUID6()
+	require.NoError(t, err)
+	assert.True(t, IsUUID6(string(u6)))
+	assert.True(t, IsUUID(string(u6)))
+	testValid(t, "uuid", string(u6))
+}
+
+func TestFormatUUID7(t *testing.T) {
+	first7 := uuid.Must(uuid.NewV7())
+	other4 := uuid.Must(uuid.NewRandom())
+	other5 := uuid.NewSHA1(uuid.NameSpaceURL, []byte("somewhereelse.com"))
+	other7 := uuid.Must(uuid.NewV7())
+	uuid7 := UUID7(first7.String())
+	str := other7.String()
+	testStringFormat(t, &uuid7, "uuid7", str,
+		[]string{
+			other7.String(),
+			strings.ReplaceAll(other7.String(), "-", ""),
+		},
+		[]string{
+			"not-a-uuid",
+			other4.String(),
+			other5.String(),
+			strings.ReplaceAll(other4.String(), "-", ""),
+			strings.Replace(other7.String(), "-", "", 2),
+		},
+	)
+
+	// special case for zero UUID
+	var uuidZero UUID7
+	err := uuidZero.UnmarshalJSON([]byte(jsonNull))
+	require.NoError(t, err)
+	assert.EqualValues(t, UUID7(""), uuidZero)
+
+	u7, err := NewUUID7()
+	require.NoError(t, err)
+	assert.True(t, IsUUID7(string(u7)))
+	assert.True(t, IsUUID(string(u7)))
+}
+
+func TestNewNameBasedUUIDs(t *testing.T) {
+	assert.Equal(t, uuid.NameSpaceDNS.String(), string(NameSpaceDNS))
+	assert.Equal(t, uuid.NameSpaceURL.String(), string(NameSpaceURL))
+	assert.Equal(t, uuid.NameSpaceOID.String(), string(NameSpaceOID))
+	assert.Equal(t, uuid.NameSpaceX500.String(), string(NameSpaceX500))
+
+	// reference values from RFC 4122 (errata) and Python's uuid module
+	u3 := NewUUID3(NameSpaceDNS, "python.org")
+	assert.Equal(t, UUID3("6fa459ea-ee8a-3ca4-894e-db77e160355e"), u3)
+	assert.True(t, IsUUID3(string(u3)))
+
+	u5 := NewUUID5(NameSpaceDNS, "python.org")
+	assert.Equal(t, UUID5("886313e1-3b8a-5372-9b90-0c9aee199e5d"), u5)
+	assert.True(t, IsUUID5(string(u5)))
+
+	assert.Equal(t, NewUUID5(NameSpaceURL, "somewhere.com"), NewUUID5(NameSpaceURL, "somewhere.com"))
+	assert.NotEqual(t, NewUUID5(NameSpaceURL, "somewhere.com"), NewUUID5(NameSpaceDNS, "somewhere.com"))
+
+	assert.Panics(t, func() { _ = NewUUID5("not-a-uuid", "somewhere.com") })
+}
+
 func TestFormatUUID(t *testing.T) {
 	first5 := uuid.NewSHA1(uuid.NameSpaceURL, []byte("somewhere.com"))
 	other3 := uuid.NewSHA1(uuid.NameSpaceURL, []byte("somewhereelse.com"))
@@ -323,6 +497,90 @@ func TestFormatISBN13(t *testing.T) {
 	testStringFormat(t, &isbn13, "isbn13", str, []string{}, []string{"978-0321751042"}) // bad checksum
 }
 
+// known conversions, as published by the ISBN agencies
+var isbnConversions = []struct {
+	isbn10 ISBN10
+	isbn13 ISBN13
+}{
+	{"0306406152", "9780306406157"},
+	{"0198534531", "9780198534532"},
+	{"0747532699", "9780747532699"},
+	{"0596520689", "9780596520687"},
+	{"080442957X", "9780804429573"},
+	{"0262033844", "9780262033848"},
+	{"0131103628", "9780131103627"},
+	{"0201633612", "9780201633610"},
+}
+
+func TestISBN_Conversions(t *testing.T) {
+	for _, tc := range isbnConversions {
+		isbn13, err := tc.isbn10.ToISBN13()
+		require.NoErrorf(t, err, "unexpected error converting %q", tc.isbn10)
+		assert.Equal(t, tc.isbn13, isbn13)
+		testValid(t, "isbn13", string(isbn13))
+
+		isbn10, err := tc.isbn13.ToISBN10()
+		require.NoErrorf(t, err, "unexpected error converting %q", tc.isbn13)
+		assert.Equal(t, tc.isbn10, isbn10)
+		assert.True(t, LuhnISBN10(string(isbn10)))
+
+		assert.Equal(t, ISBN(tc.isbn13), ISBN(tc.isbn10).Normalize())
+		assert.Equal(t, ISBN(tc.isbn13), ISBN(tc.isbn13).Normalize())
+	}
+
+	// separators are ignored
+	isbn13, err := ISBN10("0-306-40615-2").ToISBN13()
+	require.NoError(t, err)
+	assert.Equal(t, ISBN13("9780306406157"), isbn13)
+	isbn10, err := ISBN13("978-0-306-40615-7").ToISBN10()
+	require.NoError(t, err)
+	assert.Equal(t, ISBN10("0306406152"), isbn10)
+	assert.Equal(t, ISBN("9780306406157"), ISBN("0 306 40615 2").Normalize())
+	assert.Equal(t, ISBN("9780306406157"), ISBN("978-0-306-40615-7").Normalize())
+
+	// invalid conversions
+	_, err = ISBN10("0306406153").ToISBN13()
+	require.Error(t, err)
+	_, err = ISBN10("030640615").ToISBN13()
+	require.Error(t, err)
+	_, err = ISBN13("9780306406158").ToISBN10()
+	require.Error(t, err)
+	_, err = ISBN13("979-10-90636-07-1").ToISBN10()
+	require.Error(t, err)
+	_, err = ISBN13("978030640615").ToISBN10()
+	require.Error(t, err)
+	assert.Equal(t, ISBN("0306406153"), ISBN("0-306-40615-3").Normalize())
+}
+
+func TestLuhnISBN10(t *testing.T) {
+	for _, valid := range []string{"0306406152", "0-306-40615-2", "080442957X", "080442957x", "0 8044 2957 X"} {
+		assert.Truef(t, LuhnISBN10(valid), "expected %q to have a valid check digit", valid)
+	}
+	for _, invalid := range []string{"", "0306406153", "030640615", "03064061522", "X306406152", "03064O6152"} {
+		assert.Falsef(t, LuhnISBN10(invalid), "expected %q to have an invalid check digit", invalid)
+	}
+}
+
+func TestEAN13CheckDigit(t *testing.T) {
+	for input, expected := range map[string]byte{
+		"978030640615":    '7',
+		"978-0-306-40615": '7',
+		"979109063607":    '1',
+		"978080442957":    '3',
+		"400638133393":    '1',
+		"000000000000":    '0',
+	} {
+		check, err := EAN13CheckDigit(input)
+		require.NoErrorf(t, err, "unexpected error for %q", input)
+		assert.Equalf(t, expected, check, "unexpected check digit for %q", input)
+	}
+
+	for _, invalid := range []string{"", "97803064061", "9780306406157", "97803064061X"} {
+		_, err := EAN13CheckDigit(invalid)
+		require.Errorf(t, err, "expected an error for %q", invalid)
+	}
+}
+
 func TestFormatHexColor(t *testing.T) {
 	hexColor := HexColor("#FFFFFF")
 	str := string("#000000")
@@ -338,7 +596,40 @@ func TestFormatRGBColor(t *testing.T) {
 func TestFormatSSN(t *testing.T) {
 	ssn := SSN("111-11-1111")
 	str := string("999 99 9999")
-	testStringFormat(t, &ssn, "ssn", str, []string{}, []string{"999 99 999"})
+	testStringFormat(t, &ssn, "ssn", str, []string{"111111111", "123 45 6789", "123-45-6789"}, []string{"999 99 999", "1234567890", "123_45_6789", "12-345-6789", "abc-de-fghi", "123-45 6789", "12345-6789", "123 45-6789", "123-456789"})
+}
+
+func TestSSN_Components(t *testing.T) {
+	for _, ssn := range []SSN{"123-45-6789", "123 45 6789", "123456789"} {
+		assert.Equal(t, "123", ssn.Area())
+		assert.Equal(t, "45", ssn.Group())
+		assert.Equal(t, "6789", ssn.Serial())
+		assert.Equal(t, "***-**-6789", ssn.Mask())
+	}
+
+	invalid := SSN("123-45-678")
+	assert.Empty(t, invalid.Area())
+	assert.Empty(t, invalid.Group())
+	assert.Empty(t, invalid.Serial())
+	assert.Equal(t, "***-**-****", invalid.Mask())
+}
+
+func TestSSN_IsValid(t *testing.T) {
+	for _, valid := range []SSN{"123-45-6789", "001-01-0001", "665 99 9999", "667010001", "799-99-9999"} {
+		assert.Truef(t, valid.IsValid(), "expected %q to be a valid SSN", valid)
+	}
+	for _, invalid := range []SSN{
+		"000-45-6789", // area 000
+		"666-45-6789", // area 666
+		"800-45-6789", // area 800-999
+		"999-45-6789",
+		"123-00-6789", // group 00
+		"123-45-0000", // serial 0000
+		"123-45-678",  // invalid format
+		"",
+	} {
+		assert.Falsef(t, invalid.IsValid(), "expected %q to be an invalid SSN", invalid)
+	}
 }
 
 func TestFormatCreditCard(t *testing.T) {
@@ -347,11 +638,174 @@ func TestFormatCreditCard(t *testing.T) {
 	testStringFormat(t, &creditCard, "creditcard", str, []string{}, []string{"9999-9999-9999-999"})
 }
 
+func TestCreditCard_Network(t *testing.T) {
+	for _, tc := range []struct {
+		card     CreditCard
+		expected string
+	}{
+		{card: "4111-1111-1111-1111", expected: "Visa"},
+		{card: "5555 5555 5555 4444", expected: "Mastercard"},
+		{card: "2223003122003222", expected: "Mastercard"},
+		{card: "3782-822463-10005", expected: "Amex"},
+		{card: "371449635398431", expected: "Amex"},
+		{card: "6011111111111117", expected: "Discover"},
+		{card: "6445644564456445", expected: "Discover"},
+		{card: "6200000000000005", expected: "UnionPay"},
+		{card: "3530111333300000", expected: "Unknown"},
+		{card: "", expected: "Unknown"},
+	} {
+		assert.Equalf(t, tc.expected, tc.card.Network(), "unexpected network for %q", tc.card)
+	}
+}
+
+func TestCreditCard_Mask(t *testing.T) {
+	assert.Equal(t, "****-****-****-1881", CreditCard("4012-8888-8888-1881").Mask())
+	assert.Equal(t, "************1881", CreditCard("4012888888881881").Mask())
+	assert.Equal(t, "**** ****** *0005", CreditCard("3782 822463 10005").Mask())
+	assert.Equal(t, "123", CreditCard("123").Mask())
+
+	assert.Equal(t, "4012888888881881", CreditCard("4012-8888-8888-1881").Digits())
+	assert.Equal(t, "378282246310005", CreditCard("3782 822463 10005").Digits())
+}
+
+func TestLuhnCheck(t *testing.T) {
+	assert.True(t, LuhnCheck("4012888888881881"))
+	assert.True(t, LuhnCheck("79927398713"))
+	assert.True(t, LuhnCheck(CreditCard("4111-1111-1111-1111").Digits()))
+	assert.False(t, LuhnCheck("4012888888881882"))
+	assert.False(t, LuhnCheck("4012-8888-8888-1881"))
+	assert.False(t, LuhnCheck(""))
+}
+
 func TestFormatPassword(t *testing.T) {
 	password := Password("super secret stuff here")
 	testStringFormat(t, &password, "password", "super secret!!!", []string{"even more secret"}, []string{})
 }
 
+func TestPassword_Redaction(t *testing.T) {
+	type credentials struct {
+		User     string   `json:"user"`
+		Password Password `json:"password"`
+	}
+	creds := credentials{User: "admin", Password: Password("super secret stuff here")}
+
+	b, err := json.Marshal(creds)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"user":"admin","password":"super secret stuff here"}`, string(b))
+
+	b, err = creds.Password.RedactedJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"[REDACTED]"`, string(b))
+
+	PasswordRedaction = true
+	defer func() { PasswordRedaction = false }()
+
+	b, err = json.Marshal(creds)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"user":"admin","password":"[REDACTED]"}`, string(b))
+	assert.NotContains(t, string(b), "secret")
+
+	// round-tripping a redacted password yields the redacted placeholder
+	var decoded credentials
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, Password("[REDACTED]"), decoded.Password)
+
+	// text marshaling is not affected
+	txt, err := creds.Password.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("super secret stuff here"), txt)
+}
+
+func TestPassword_String(t *testing.T) {
+	const secret = "super secret stuff here"
+	password := Password(secret)
+	assert.Equal(t, "[REDACTED]", password.Redact())
+	assert.Equal(t, "[REDACTED]", Password("").Redact())
+	assert.Equal(t, secret, password.Plaintext())
+
+	// encoders, such as request parameter serializers, use String
+	assert.Equal(t, secret, password.String())
+
+	for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%12s"} {
+		assert.NotContainsf(t, fmt.Sprintf(verb, password), "secret", "%s revealed the password", verb)
+		assert.NotContainsf(t, fmt.Sprintf(verb, &password), "secret", "%s revealed the password", verb)
+	}
+	assert.Equal(t, "[REDACTED]", fmt.Sprint(password))
+	assert.Equal(t, `"[REDACTED]"`, fmt.Sprintf("%q", password))
+	assert.Equal(t, `strfmt.Password("[REDACTED]")`, fmt.Sprintf("%#v", password))
+	type credentials struct {
+		User     string
+		Password Password
+	}
+	assert.NotContains(t, fmt.Sprintf("%+v", credentials{User: "admin", Password: password}), "secret")
+	assert.NotContains(t, fmt.Errorf("login failed: %v", password).Error(), "secret")
+
+	// encodings for storage keep the actual password
+	txt, err := password.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, secret, string(txt))
+	val, err := password.Value()
+	require.NoError(t, err)
+	assert.Equal(t, secret, val)
+	b, err := password.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"`+secret+`"`, string(b))
+}
+
+func TestPassword_Entropy(t *testing.T) {
+	assert.InDelta(t, 0, Password("").Entropy(), 1e-9)
+	assert.InDelta(t, 8*math.Log2(26), Password("abcdefgh").Entropy(), 1e-9)
+	assert.InDelta(t, 8*math.Log2(62), Password("abcDEF12").Entropy(), 1e-9)
+	assert.InDelta(t, 8*math.Log2(95), Password("abcDE1!?").Entropy(), 1e-9)
+	assert.Greater(t, Password("correct horse battery staple").Entropy(), Password("Tr0ub4dor&3").Entropy())
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:        8,
+		MaxLength:        16,
+		MinEntropy:       40,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+	}
+
+	assert.Empty(t, policy.Validate(Password("Sup3r-Secret")))
+	assert.Len(t, policy.Validate(Password("abc")), 5)
+	assert.Len(t, policy.Validate(Password("SUPER-SECRET-123456")), 2)
+	assert.Len(t, policy.Validate(Password("")), 6)
+
+	assert.Empty(t, PasswordPolicy{}.Validate(Password("")))
+}
+
+type testBreachChecker map[string]bool
+
+func (c testBreachChecker) IsBreached(password string) (bool, error) {
+	if password == "" {
+		return false, errors.New("empty password")
+	}
+	return c[password], nil
+}
+
+func TestPassword_IsBreached(t *testing.T) {
+	checker := testBreachChecker{"password123": true}
+
+	breached, err := Password("password123").IsBreached(checker)
+	require.NoError(t, err)
+	assert.True(t, breached)
+
+	breached, err = Password("Sup3r-Secret").IsBreached(checker)
+	require.NoError(t, err)
+	assert.False(t, breached)
+
+	_, err = Password("").IsBreached(checker)
+	require.Error(t, err)
+
+	_, err = Password("password123").IsBreached(nil)
+	require.Error(t, err)
+}
+
 func TestFormatBase64(t *testing.T) {
 	const b64 string = "This is a byte array with unprintable chars, but it also isn"
 	str := base64.URLEncoding.EncodeToString([]byte(b64))
@@ -408,6 +862,131 @@ func TestFormatBase64(t *testing.T) {
 
 	err = subj4.Scan(123)
 	require.Error(t, err)
+
+	// decoded content
+	decoded, err := subj4.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, []byte(b64), decoded)
+
+	l, err := subj4.Len()
+	require.NoError(t, err)
+	assert.Equal(t, len(b64), l)
+}
+
+func TestFormatBase64Std(t *testing.T) {
+	const b64 string = "????>>>> this needs some special chars"
+	str := base64.StdEncoding.EncodeToString([]byte(b64))
+	expected := Base64Std(b64)
+	bj := []byte("\"" + str + "\"")
+	require.Contains(t, str, "/")
+
+	var subj Base64Std
+	require.NoError(t, subj.UnmarshalText([]byte(str)))
+	assert.EqualValues(t, expected, subj)
+
+	b, err := subj.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, []byte(str), b)
+	assert.Equal(t, str, subj.String())
+
+	var subj2 Base64Std
+	require.NoError(t, subj2.UnmarshalJSON(bj))
+	assert.EqualValues(t, expected, subj2)
+	require.Error(t, subj2.UnmarshalJSON([]byte(`"Pz8_Pz8-"`)))
+	require.Error(t, subj2.UnmarshalJSON([]byte(`[]`)))
+
+	b, err = subj2.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, bj, b)
+
+	bsonData, err := bson.Marshal(subj2)
+	require.NoError(t, err)
+
+	var b64Copy Base64Std
+	require.NoError(t, bson.Unmarshal(bsonData, &b64Copy))
+	assert.Equal(t, subj2, b64Copy)
+
+	testValid(t, "byte-std", str)
+	testValid(t, "bytestd", str)
+	testInvalid(t, "byte-std", "Pz8_Pz8-")
+	testInvalid(t, "byte-std", "ZWxpemFiZXRocG9zZXk") // missing pad char
+
+	sqlvalue, err := subj2.Value()
+	require.NoError(t, err)
+	assert.Equal(t, str, sqlvalue)
+
+	var subj3 Base64Std
+	require.NoError(t, subj3.Scan([]byte(str)))
+	assert.Equal(t, expected, subj3)
+	require.NoError(t, subj3.Scan(str))
+	assert.Equal(t, expected, subj3)
+	require.Error(t, subj3.Scan(123))
+
+	decoded, err := subj3.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, []byte(b64), decoded)
+
+	l, err := subj3.Len()
+	require.NoError(t, err)
+	assert.Equal(t, len(b64), l)
+}
+
+func TestFormatBase64URL(t *testing.T) {
+	const b64 string = "????>>>> this needs some special chars"
+	str := base64.URLEncoding.EncodeToString([]byte(b64))
+	expected := Base64URL(b64)
+	bj := []byte("\"" + str + "\"")
+	require.Contains(t, str, "_")
+
+	var subj Base64URL
+	require.NoError(t, subj.UnmarshalText([]byte(str)))
+	assert.EqualValues(t, expected, subj)
+
+	b, err := subj.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, []byte(str), b)
+	assert.Equal(t, str, subj.String())
+
+	var subj2 Base64URL
+	require.NoError(t, subj2.UnmarshalJSON(bj))
+	assert.EqualValues(t, expected, subj2)
+	require.Error(t, subj2.UnmarshalJSON([]byte(`"Pz8/Pz8+"`)))
+	require.Error(t, subj2.UnmarshalJSON([]byte(`[]`)))
+
+	b, err = subj2.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, bj, b)
+
+	bsonData, err := bson.Marshal(subj2)
+	require.NoError(t, err)
+
+	var b64Copy Base64URL
+	require.NoError(t, bson.Unmarshal(bsonData, &b64Copy))
+	assert.Equal(t, subj2, b64Copy)
+
+	testValid(t, "byte-url", str)
+	testValid(t, "byteurl", str)
+	testInvalid(t, "byte-url", "Pz8/Pz8+")
+	testInvalid(t, "byte-url", "ZWxpemFiZXRocG9zZXk") // missing pad char
+
+	sqlvalue, err := subj2.Value()
+	require.NoError(t, err)
+	assert.Equal(t, str, sqlvalue)
+
+	var subj3 Base64URL
+	require.NoError(t, subj3.Scan([]byte(str)))
+	assert.Equal(t, expected, subj3)
+	require.NoError(t, subj3.Scan(str))
+	assert.Equal(t, expected, subj3)
+	require.Error(t, subj3.Scan(123))
+
+	decoded, err := subj3.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, []byte(b64), decoded)
+
+	l, err := subj3.Len()
+	require.NoError(t, err)
+	assert.Equal(t, len(b64), l)
 }
 
 type testableFormat interface {
@@ -529,6 +1108,68 @@ func testInvalid(t *testing.T, name, value string) {
 	}
 }
 
+func TestBase64_Streams(t *testing.T) {
+	payload := []byte("elizabethposey and some more bytes")
+
+	t.Run("should read the decoded content", func(t *testing.T) {
+		b64 := Base64(payload)
+		r, err := b64.Reader()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, payload, content)
+	})
+
+	t.Run("should append content from a reader", func(t *testing.T) {
+		b64 := Base64(payload[:10])
+		require.NoError(t, b64.AppendFrom(strings.NewReader(string(payload[10:]))))
+		assert.Equal(t, Base64(payload), b64)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(payload), b64.String())
+
+		require.Error(t, b64.AppendFrom(iotest.ErrReader(errors.New("read failure"))))
+	})
+
+	t.Run("should stream the encoded content", func(t *testing.T) {
+		var buf strings.Builder
+		w := NewBase64Writer(&buf)
+		_, err := w.Write(payload[:7])
+		require.NoError(t, err)
+		_, err = w.Write(payload[7:])
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		var b64 Base64
+		require.NoError(t, json.Unmarshal([]byte(`"`+buf.String()+`"`), &b64))
+		assert.Equal(t, Base64(payload), b64)
+	})
+}
+
+func TestBase64_Equal(t *testing.T) {
+	payload := []byte{0xfb, 0xff, 0xbf, 'a'}
+
+	var fromStd Base64
+	require.NoError(t, json.Unmarshal([]byte(`"+/+/YQ=="`), &fromStd))
+	var fromURL Base64
+	require.NoError(t, fromURL.UnmarshalText([]byte("-_-_YQ==")))
+
+	assert.True(t, fromStd.Equal(fromURL))
+	assert.True(t, fromURL.Equal(fromStd))
+	assert.True(t, fromStd.DeepEqual(fromURL))
+	assert.True(t, fromStd.EqualBytes(payload))
+	assert.True(t, Base64(nil).Equal(Base64{}))
+	assert.True(t, Base64(nil).EqualBytes(nil))
+
+	assert.False(t, fromStd.Equal(Base64(payload[:3])))
+	assert.False(t, fromStd.DeepEqual(nil))
+	assert.False(t, fromStd.EqualBytes([]byte("+/+/YQ==")))
+
+	// invalid encodings never make it into a Base64
+	var invalid Base64
+	require.Error(t, invalid.UnmarshalText([]byte("+/+/YQ==")))
+	require.Error(t, json.Unmarshal([]byte(`"-_-_YQ=="`), &invalid))
+	assert.False(t, invalid.Equal(fromStd))
+}
+
 func TestDeepCopyBase64(t *testing.T) {
 	b64 := Base64("ZWxpemFiZXRocG9zZXk=")
 	in := &b64
@@ -545,6 +1186,38 @@ func TestDeepCopyBase64(t *testing.T) {
 	assert.Nil(t, out3)
 }
 
+func TestDeepCopyBase64Std(t *testing.T) {
+	b64 := Base64Std("ZWxpemFiZXRocG9zZXk=")
+	in := &b64
+
+	out := new(Base64Std)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *Base64Std
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestDeepCopyBase64URL(t *testing.T) {
+	b64 := Base64URL("ZWxpemFiZXRocG9zZXk=")
+	in := &b64
+
+	out := new(Base64URL)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *Base64URL
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
 func TestDeepCopyURI(t *testing.T) {
 	uri := URI("http://somewhere.com")
 	in := &uri
@@ -725,6 +1398,38 @@ func TestDeepCopyUUID5(t *testing.T) {
 	assert.Nil(t, out3)
 }
 
+func TestDeepCopyUUID6(t *testing.T) {
+	uuid6 := UUID6(uuid.Must(uuid.NewV6()).String())
+	in := &uuid6
+
+	out := new(UUID6)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *UUID6
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestDeepCopyUUID7(t *testing.T) {
+	uuid7 := UUID7(uuid.Must(uuid.NewV7()).String())
+	in := &uuid7
+
+	out := new(UUID7)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *UUID7
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
 func TestDeepCopyISBN(t *testing.T) {
 	isbn := ISBN("0321751043")
 	in := &isbn
@@ -886,6 +1591,106 @@ func BenchmarkIsUUID(b *testing.B) {
 	b.Run("IsUUIDv5 - regexp", benchmarkIs(uuid5s, func(id string) bool { return rxUUID5.MatchString(id) }))
 }
 
+func BenchmarkIsEmail(b *testing.B) {
+	const variants = 5
+	validTemplates := []string{
+		"user%d@example.com",
+		"first.last%d@sub.example.org",
+		"email+tag%d@gmail.com",
+		"%d_somename@example.com",
+		"Miles.O'Brian%d@example.com",
+		`"Fred Bloggs %d"@example.com`,
+		"root%d@localhost",
+		"postmaster%d@☁→❄→☃→☀→☺→☂→☹→✝.ws",
+		"Name %d <name@example.com>",
+		"!#$%%&'*+-/=?^_`{}|~%d@example.com",
+	}
+	invalidTemplates := []string{
+		"user%d",
+		"user%d@",
+		"@example%d.com",
+		"user%d@@example.com",
+		"somebody%d@somewhere@com",
+		"user %d@example.com",
+		"user%d@example..com",
+		"user%d@.example.com",
+		"<user%d@example.com",
+		"user%d.@example.com",
+	}
+
+	emails := make([]string, 0, variants*(len(validTemplates)+len(invalidTemplates)))
+	for i := 0; i < variants; i++ {
+		for _, tpl := range validTemplates {
+			emails = append(emails, fmt.Sprintf(tpl, i))
+		}
+		for _, tpl := range invalidTemplates {
+			emails = append(emails, fmt.Sprintf(tpl, i))
+		}
+	}
+
+	b.Run("IsEmail - net/mail", benchmarkIs(emails, IsEmail))
+}
+
+func BenchmarkIsIPv4(b *testing.B) {
+	ipv4s := []string{
+		"192.168.254.1",
+		"0.0.0.0",
+		"255.255.255.255",
+		"10.0.0.1",
+		"198.168.254.2.2",
+		"256.0.0.1",
+		"1.2.3",
+		"::1",
+	}
+
+	b.Run("IsIPv4 - govalidator", benchmarkIs(ipv4s, govalidator.IsIPv4))
+}
+
+func BenchmarkIsIPv6(b *testing.B) {
+	ipv6s := []string{
+		"::1",
+		"2001:db8:a0b:12f0::1",
+		"fe80::1%eth0",
+		"::ffff:192.0.2.1",
+		"127.0.0.1",
+		"2001:db8::g",
+		"1:2:3:4:5:6:7:8:9",
+		"",
+	}
+
+	b.Run("IsIPv6 - govalidator", benchmarkIs(ipv6s, govalidator.IsIPv6))
+}
+
+func BenchmarkIsCIDR(b *testing.B) {
+	cidrs := []string{
+		"192.0.2.1/24",
+		"2001:db8:a0b:12f0::1/32",
+		"10.0.0.0/8",
+		"::/0",
+		"198.168.254.2",
+		"2001:db8:a0b:12f0::1",
+		"192.0.2.1/33",
+		"192.0.2.1/",
+	}
+
+	b.Run("IsCIDR - govalidator", benchmarkIs(cidrs, govalidator.IsCIDR))
+}
+
+func BenchmarkIsURI(b *testing.B) {
+	uris := []string{
+		"http://somewhere.com",
+		"https://user@example.com:8443/a/b%20c?x=1&y=2#frag",
+		"/relative/path",
+		"ftp://ftp.example.com/pub/file.txt",
+		"somewhere.com",
+		"http://[::1",
+		"://missing-scheme",
+		"",
+	}
+
+	b.Run("IsURI - govalidator.IsRequestURI", benchmarkIs(uris, govalidator.IsRequestURI))
+}
+
 func benchmarkIs(input []string, fn func(string) bool) func(*testing.B) {
 	return func(b *testing.B) {
 		var isTrue bool
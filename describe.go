@@ -0,0 +1,333 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+// FormatDescription documents a string format, e.g. for API documentation generators
+type FormatDescription struct {
+	// Name is the name the format is registered with
+	Name string
+	// Title is a short human-readable name for the format
+	Title string
+	// Description explains what values of the format stand for
+	Description string
+	// Example is a valid value of the format
+	Example string
+	// RFC refers to the specification of the format, if any (e.g. "RFC 3339")
+	RFC string
+}
+
+// builtinDescriptions documents the built-in formats of this package
+var builtinDescriptions = map[string]FormatDescription{
+	"asn": {
+		Title:       "Autonomous system number",
+		Description: "An autonomous system number, with or without the AS prefix.",
+		Example:     "AS64496",
+		RFC:         "RFC 6793",
+	},
+	"bic": {
+		Title:       "Business identifier code",
+		Description: "An ISO 9362 business identifier code, also known as SWIFT code.",
+		Example:     "DEUTDEFF500",
+	},
+	"bsonobjectid": {
+		Title:       "BSON ObjectId",
+		Description: "A MongoDB BSON ObjectId, as 24 hexadecimal characters.",
+		Example:     "507f1f77bcf86cd799439011",
+	},
+	"byte": {
+		Title:       "Base64 encoded bytes",
+		Description: "Binary data encoded in base64.",
+		Example:     "aGVsbG8gd29ybGQ=",
+		RFC:         "RFC 4648",
+	},
+	"byte-std": {
+		Title:       "Standard base64 encoded bytes",
+		Description: "Binary data encoded in base64, with the standard alphabet.",
+		Example:     "aGVsbG8/d29ybGQ+",
+		RFC:         "RFC 4648",
+	},
+	"byte-url": {
+		Title:       "URL-safe base64 encoded bytes",
+		Description: "Binary data encoded in base64, with the URL and filename safe alphabet.",
+		Example:     "aGVsbG8_d29ybGQ-",
+		RFC:         "RFC 4648",
+	},
+	"cidr": {
+		Title:       "CIDR block",
+		Description: "An IP address and a prefix length, in Classless Inter-Domain Routing notation.",
+		Example:     "192.0.2.0/24",
+		RFC:         "RFC 4632",
+	},
+	"cidr-list": {
+		Title:       "List of CIDR blocks",
+		Description: "A list of CIDR blocks, separated by commas or new lines.",
+		Example:     "10.0.0.0/8, 192.168.0.0/16",
+		RFC:         "RFC 4632",
+	},
+	"creditcard": {
+		Title:       "Credit card number",
+		Description: "A payment card number, with a valid Luhn check digit.",
+		Example:     "4111-1111-1111-1111",
+	},
+	"cron": {
+		Title:       "Cron expression",
+		Description: "A cron schedule, with 5 or 6 fields.",
+		Example:     "*/15 9-17 * * MON-FRI",
+	},
+	"data-url": {
+		Title:       "Data URL",
+		Description: `A "data:" URL, embedding some data in the URL itself.`,
+		Example:     "data:text/plain;base64,aGVsbG8=",
+		RFC:         "RFC 2397",
+	},
+	"date": {
+		Title:       "Date",
+		Description: "A calendar date, without time or time zone.",
+		Example:     "2024-01-15",
+		RFC:         "RFC 3339",
+	},
+	"datetime": {
+		Title:       "Date and time",
+		Description: "A date and time of day, with a time zone offset.",
+		Example:     "2024-01-15T08:00:00.000Z",
+		RFC:         "RFC 3339",
+	},
+	"duration": {
+		Title:       "Duration",
+		Description: "A period of time, e.g. in the notation of Go durations or in plain words.",
+		Example:     "3 weeks",
+	},
+	"email": {
+		Title:       "Email address",
+		Description: "An email address, optionally with a display name.",
+		Example:     "someone@example.com",
+		RFC:         "RFC 5322",
+	},
+	"email-list": {
+		Title:       "List of email addresses",
+		Description: "A comma-separated list of email addresses.",
+		Example:     "alice@example.com, bob@example.com",
+		RFC:         "RFC 5322",
+	},
+	"epoch": {
+		Title:       "Unix timestamp",
+		Description: "A number of seconds elapsed since January 1, 1970 UTC.",
+		Example:     "1705305600",
+	},
+	"epoch-millis": {
+		Title:       "Unix timestamp in milliseconds",
+		Description: "A number of milliseconds elapsed since January 1, 1970 UTC.",
+		Example:     "1705305600000",
+	},
+	"epoch-nanos": {
+		Title:       "Unix timestamp in nanoseconds",
+		Description: "A number of nanoseconds elapsed since January 1, 1970 UTC.",
+		Example:     "1705305600000000000",
+	},
+	"geo-coordinate": {
+		Title:       "Geographic coordinate",
+		Description: "A latitude and longitude pair, in decimal degrees.",
+		Example:     "48.8566,2.3522",
+	},
+	"hex": {
+		Title:       "Hexadecimal string",
+		Description: "A sequence of bytes encoded as hexadecimal characters, such as keys, fingerprints or digests.",
+		Example:     "d41d8cd98f00b204e9800998ecf8427e",
+	},
+	"hexcolor": {
+		Title:       "Hexadecimal color",
+		Description: "A CSS color in the hexadecimal notation.",
+		Example:     "#FFA500",
+	},
+	"hostname": {
+		Title:       "Hostname",
+		Description: "An internet host name.",
+		Example:     "www.example.com",
+		RFC:         "RFC 1034",
+	},
+	"hostname-list": {
+		Title:       "List of hostnames",
+		Description: "A comma-separated list of internet host names.",
+		Example:     "example.com, www.example.com",
+		RFC:         "RFC 1034",
+	},
+	"hslcolor": {
+		Title:       "HSL color",
+		Description: "A CSS color in the HSL notation.",
+		Example:     "hsl(270,50%,40%)",
+	},
+	"idn-email": {
+		Title:       "Internationalized email address",
+		Description: "An email address, which may contain non-ASCII characters.",
+		Example:     "用户@例子.广告",
+		RFC:         "RFC 6531",
+	},
+	"idn-hostname": {
+		Title:       "Internationalized hostname",
+		Description: "An internet host name, which may contain non-ASCII characters.",
+		Example:     "bücher.example",
+		RFC:         "RFC 5890",
+	},
+	"ipv4": {
+		Title:       "IPv4 address",
+		Description: "An IP version 4 address, in dotted decimal notation.",
+		Example:     "192.0.2.1",
+		RFC:         "RFC 791",
+	},
+	"ipv6": {
+		Title:       "IPv6 address",
+		Description: "An IP version 6 address.",
+		Example:     "2001:db8::1",
+		RFC:         "RFC 4291",
+	},
+	"iri-reference": {
+		Title:       "IRI reference",
+		Description: "An internationalized resource identifier, either absolute or relative.",
+		Example:     "../résumé.html",
+		RFC:         "RFC 3987",
+	},
+	"isbn": {
+		Title:       "ISBN",
+		Description: "An international standard book number, either ISBN-10 or ISBN-13.",
+		Example:     "978-0-321-75104-1",
+	},
+	"isbn10": {
+		Title:       "ISBN-10",
+		Description: "A 10 digits international standard book number.",
+		Example:     "0-321-75104-3",
+	},
+	"isbn13": {
+		Title:       "ISBN-13",
+		Description: "A 13 digits international standard book number.",
+		Example:     "978-0-321-75104-1",
+	},
+	"json-pointer": {
+		Title:       "JSON pointer",
+		Description: "A pointer to a value within a JSON document.",
+		Example:     "/definitions/pet/properties/name",
+		RFC:         "RFC 6901",
+	},
+	"mac": {
+		Title:       "MAC address",
+		Description: "A hardware address of a network interface, in colon, hyphen or dot notation.",
+		Example:     "01:23:45:67:89:ab",
+	},
+	"mime-type": {
+		Title:       "Media type",
+		Description: "A media type, also known as MIME type, with optional parameters.",
+		Example:     "application/json; charset=utf-8",
+		RFC:         "RFC 6838",
+	},
+	"network-address": {
+		Title:       "Network address",
+		Description: "A host name or IP address, and a port.",
+		Example:     "example.com:8080",
+	},
+	"password": {
+		Title:       "Password",
+		Description: "A secret, which should not be displayed.",
+		Example:     "correct horse battery staple",
+	},
+	"port": {
+		Title:       "Port number",
+		Description: "A TCP or UDP port number.",
+		Example:     "8080",
+	},
+	"regex": {
+		Title:       "Regular expression",
+		Description: "A regular expression, in the syntax of the Go regexp package.",
+		Example:     "^[a-z]+$",
+	},
+	"rgbcolor": {
+		Title:       "RGB color",
+		Description: "A CSS color in the RGB notation.",
+		Example:     "rgb(255,165,0)",
+	},
+	"ssn": {
+		Title:       "Social security number",
+		Description: "A US social security number.",
+		Example:     "123-45-6789",
+	},
+	"timezone": {
+		Title:       "Time zone",
+		Description: "A time zone name, from the IANA time zone database.",
+		Example:     "America/New_York",
+	},
+	"ulid": {
+		Title:       "ULID",
+		Description: "A universally unique lexicographically sortable identifier.",
+		Example:     "01EYXZVGBHG26MFTG4JWR4K558",
+	},
+	"uri": {
+		Title:       "URI",
+		Description: "An absolute URI, or an absolute path.",
+		Example:     "https://example.com/pets?tags=dog",
+		RFC:         "RFC 3986",
+	},
+	"uri-reference": {
+		Title:       "URI reference",
+		Description: "A uniform resource identifier, either absolute or relative.",
+		Example:     "../pets/1",
+		RFC:         "RFC 3986",
+	},
+	"uri-template": {
+		Title:       "URI template",
+		Description: "A URI with variables to be expanded.",
+		Example:     "/users/{id}{?fields*}",
+		RFC:         "RFC 6570",
+	},
+	"uuid": {
+		Title:       "UUID",
+		Description: "A universally unique identifier, of any version.",
+		Example:     "a8098c1a-f86e-11da-bd1a-00112444be1e",
+		RFC:         "RFC 9562",
+	},
+	"uuid3": {
+		Title:       "UUID version 3",
+		Description: "A name-based universally unique identifier, hashed with MD5.",
+		Example:     "6fa459ea-ee8a-3ca4-894e-db77e160355e",
+		RFC:         "RFC 9562",
+	},
+	"uuid4": {
+		Title:       "UUID version 4",
+		Description: "A random universally unique identifier.",
+		Example:     "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		RFC:         "RFC 9562",
+	},
+	"uuid5": {
+		Title:       "UUID version 5",
+		Description: "A name-based universally unique identifier, hashed with SHA-1.",
+		Example:     "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+		RFC:         "RFC 9562",
+	},
+	"uuid6": {
+		Title:       "UUID version 6",
+		Description: "A time-ordered universally unique identifier, field compatible with version 1.",
+		Example:     "1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+		RFC:         "RFC 9562",
+	},
+	"uuid7": {
+		Title:       "UUID version 7",
+		Description: "A time-ordered universally unique identifier, based on the unix timestamp in milliseconds.",
+		Example:     "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		RFC:         "RFC 9562",
+	},
+	"wildcard-hostname": {
+		Title:       "Wildcard hostname",
+		Description: "An internet host name, with an optional leading wildcard label as in TLS certificates.",
+		Example:     "*.example.com",
+		RFC:         "RFC 6125",
+	},
+}
@@ -0,0 +1,94 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/go-openapi/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltinDescriptions(t *testing.T) {
+	registry := asFormatRegistry(t, NewFormats())
+	registry.Reset()
+
+	for _, builtin := range builtinFormats {
+		desc, err := registry.Describe(builtin.name)
+		require.NoError(t, err)
+		assert.Equal(t, builtin.name, desc.Name)
+		assert.NotEmptyf(t, desc.Title, "missing title for format %s", builtin.name)
+		assert.NotEmptyf(t, desc.Description, "missing description for format %s", builtin.name)
+		assert.Truef(t, registry.Validates(builtin.name, desc.Example), "invalid example for format %s: %q", builtin.name, desc.Example)
+	}
+	assert.Len(t, builtinDescriptions, len(builtinFormats))
+
+	desc, err := registry.Describe("date-time")
+	require.NoError(t, err)
+	assert.Equal(t, "datetime", desc.Name)
+	assert.Equal(t, "RFC 3339", desc.RFC)
+}
+
+func TestRegistry_Describe(t *testing.T) {
+	registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+
+	var d Date
+	assert.True(t, registry.AddWithDescription("birth-date", &d, IsDate, FormatDescription{
+		Name:        "ignored",
+		Title:       "Birth date",
+		Description: "The date of birth of a person.",
+		Example:     "1970-01-01",
+		RFC:         "RFC 3339",
+	}))
+	assert.True(t, registry.Add("plain", &d, IsDate))
+
+	desc, err := registry.Describe("birth_date")
+	require.NoError(t, err)
+	assert.Equal(t, FormatDescription{
+		Name:        "birth-date",
+		Title:       "Birth date",
+		Description: "The date of birth of a person.",
+		Example:     "1970-01-01",
+		RFC:         "RFC 3339",
+	}, desc)
+
+	desc, err = registry.Describe("plain")
+	require.NoError(t, err)
+	assert.Equal(t, FormatDescription{Name: "plain"}, desc)
+
+	_, err = registry.Describe("unknown")
+	require.Error(t, err)
+	var apiErr errors.Error
+	require.ErrorAs(t, err, &apiErr)
+
+	// replacing the format keeps its description
+	assert.False(t, registry.Add("birth-date", &d, IsDate))
+	desc, err = registry.Describe("birth-date")
+	require.NoError(t, err)
+	assert.Equal(t, "Birth date", desc.Title)
+
+	all := registry.AllDescriptions()
+	require.Len(t, all, 2)
+	assert.Equal(t, "birth-date", all[0].Name)
+	assert.Equal(t, "plain", all[1].Name)
+
+	// descriptions are merged along with formats
+	merged := asFormatRegistry(t, NewSeededFormats(nil, nil))
+	require.NoError(t, merged.Merge(registry))
+	desc, err = merged.Describe("birth-date")
+	require.NoError(t, err)
+	assert.Equal(t, "Birth date", desc.Title)
+}
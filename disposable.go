@@ -0,0 +1,113 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"bufio"
+	"net/mail"
+	"os"
+	"strings"
+)
+
+// DisposableEmailChecker tells whether an email domain belongs to a disposable email provider
+type DisposableEmailChecker interface {
+	IsDisposable(domain string) bool
+}
+
+// Domain returns the domain part of this email address, lower cased.
+//
+// An empty string is returned when the email is not a valid address.
+func (e Email) Domain() string {
+	addr, err := mail.ParseAddress(string(e))
+	if err != nil {
+		return ""
+	}
+	at := strings.LastIndexByte(addr.Address, '@')
+	if at < 0 {
+		return ""
+	}
+	return normalizeEmailDomain(addr.Address[at+1:])
+}
+
+func normalizeEmailDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// IsDisposable returns true when the checker tells that the domain of this email is disposable.
+//
+// Invalid email addresses are never reported as disposable.
+func (e Email) IsDisposable(checker DisposableEmailChecker) bool {
+	domain := e.Domain()
+	return domain != "" && checker.IsDisposable(domain)
+}
+
+// staticDisposableChecker is a set of disposable domains
+type staticDisposableChecker map[string]struct{}
+
+// IsDisposable returns true when the domain, or any of its parent domains, is in the set
+func (c staticDisposableChecker) IsDisposable(domain string) bool {
+	domain = normalizeEmailDomain(domain)
+	for domain != "" {
+		if _, ok := c[domain]; ok {
+			return true
+		}
+		_, parent, found := strings.Cut(domain, ".")
+		if !found {
+			break
+		}
+		domain = parent
+	}
+	return false
+}
+
+// StaticDisposableChecker returns a DisposableEmailChecker reporting the specified domains as disposable,
+// as well as their subdomains.
+//
+// Domains are matched case-insensitively.
+func StaticDisposableChecker(domains []string) DisposableEmailChecker {
+	c := make(staticDisposableChecker, len(domains))
+	for _, domain := range domains {
+		if domain = normalizeEmailDomain(domain); domain != "" {
+			c[domain] = struct{}{}
+		}
+	}
+	return c
+}
+
+// FileDisposableChecker loads a list of disposable domains from a file, with one domain per line,
+// and returns a StaticDisposableChecker for these domains.
+//
+// Blank lines and lines starting with '#' are ignored.
+func FileDisposableChecker(path string) (DisposableEmailChecker, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var domains []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains = append(domains, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return StaticDisposableChecker(domains), nil
+}
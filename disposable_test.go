@@ -0,0 +1,57 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmail_Domain(t *testing.T) {
+	assert.Equal(t, "example.com", Email("john@Example.COM").Domain())
+	assert.Equal(t, "example.com", Email("John Doe <john@example.com>").Domain())
+	assert.Empty(t, Email("not an email").Domain())
+}
+
+func TestEmail_IsDisposable(t *testing.T) {
+	checker := StaticDisposableChecker([]string{"Mailinator.com", "trashmail.example.", ""})
+
+	assert.True(t, Email("john@mailinator.com").IsDisposable(checker))
+	assert.True(t, Email("john@MAILINATOR.com").IsDisposable(checker))
+	assert.True(t, Email("john@eu.mailinator.com").IsDisposable(checker))
+	assert.True(t, Email("John <john@trashmail.example>").IsDisposable(checker))
+	assert.False(t, Email("john@example.com").IsDisposable(checker))
+	assert.False(t, Email("john@notmailinator.com").IsDisposable(checker))
+	assert.False(t, Email("mailinator.com").IsDisposable(checker))
+}
+
+func TestFileDisposableChecker(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disposable.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# disposable domains\nmailinator.com\n\n  trashmail.example  \n"), 0o600))
+
+	checker, err := FileDisposableChecker(path)
+	require.NoError(t, err)
+	assert.True(t, checker.IsDisposable("mailinator.com"))
+	assert.True(t, checker.IsDisposable("trashmail.example"))
+	assert.False(t, checker.IsDisposable("# disposable domains"))
+	assert.False(t, checker.IsDisposable("example.com"))
+
+	_, err = FileDisposableChecker(filepath.Join(t.TempDir(), "missing.txt"))
+	require.Error(t, err)
+}
@@ -19,18 +19,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
 )
 
 func init() {
 	d := Duration(0)
 	// register this format in the default registry
-	Default.Add("duration", &d, IsDuration)
+	addBuiltin("duration", &d, IsDuration)
 }
 
 var (
@@ -59,6 +61,50 @@ var (
 	durationMatcher = regexp.MustCompile(`((\d+)\s*([A-Za-zµ]+))`)
 )
 
+// DurationUnit selects how a Duration is rendered in JSON.
+type DurationUnit int
+
+const (
+	// DurationString renders a Duration as a quoted string such as "1h0m0s".
+	// This is the default.
+	DurationString DurationUnit = iota
+	// DurationNanoseconds renders a Duration as a JSON number of nanoseconds.
+	DurationNanoseconds
+	// DurationMicroseconds renders a Duration as a JSON number of microseconds.
+	DurationMicroseconds
+	// DurationMilliseconds renders a Duration as a JSON number of milliseconds.
+	DurationMilliseconds
+	// DurationSeconds renders a Duration as a JSON number of seconds.
+	DurationSeconds
+)
+
+// DurationJSONUnit controls the JSON representation of Duration.
+//
+// With the default DurationString, MarshalJSON emits the usual string form.
+// Any other unit makes MarshalJSON emit a JSON number expressed in that unit.
+// UnmarshalJSON always accepts both forms; numbers are read in
+// DurationJSONUnit, or in nanoseconds when it is DurationString.
+var DurationJSONUnit = DurationString
+
+// DurationMarshalZeroAsNull makes Duration.MarshalJSON render zero durations as JSON null.
+//
+// It defaults to false: zero durations are rendered as "0s" (or 0 with a numeric DurationJSONUnit).
+// Use Duration.MarshalJSONOrNull to render null regardless of this setting.
+var DurationMarshalZeroAsNull = false
+
+func (u DurationUnit) scale() time.Duration {
+	switch u {
+	case DurationMicroseconds:
+		return time.Microsecond
+	case DurationMilliseconds:
+		return time.Millisecond
+	case DurationSeconds:
+		return time.Second
+	default:
+		return time.Nanosecond
+	}
+}
+
 // IsDuration returns true if the provided string is a valid duration
 func IsDuration(str string) bool {
 	_, err := ParseDuration(str)
@@ -150,9 +196,39 @@ func (d Duration) String() string {
 	return time.Duration(d).String()
 }
 
-// MarshalJSON returns the Duration as JSON
+// IsZero returns whether the duration is zero
+func (d Duration) IsZero() bool {
+	return d == 0
+}
+
+// MarshalJSON returns the Duration as JSON.
+//
+// Zero durations are rendered as null when DurationMarshalZeroAsNull is set.
 func (d Duration) MarshalJSON() ([]byte, error) {
-	return json.Marshal(time.Duration(d).String())
+	if DurationMarshalZeroAsNull && d.IsZero() {
+		return []byte(jsonNull), nil
+	}
+	return d.marshalJSON()
+}
+
+// MarshalJSONOrNull returns the Duration as JSON, or null if the duration is zero
+func (d Duration) MarshalJSONOrNull() ([]byte, error) {
+	if d.IsZero() {
+		return []byte(jsonNull), nil
+	}
+	return d.marshalJSON()
+}
+
+func (d Duration) marshalJSON() ([]byte, error) {
+	unit := DurationJSONUnit
+	if unit == DurationString {
+		return json.Marshal(time.Duration(d).String())
+	}
+	scale := unit.scale()
+	if time.Duration(d)%scale == 0 {
+		return []byte(strconv.FormatInt(int64(time.Duration(d)/scale), 10)), nil
+	}
+	return json.Marshal(float64(d) / float64(scale))
 }
 
 // UnmarshalJSON sets the Duration from JSON
@@ -161,6 +237,10 @@ func (d *Duration) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if len(data) > 0 && data[0] != '"' {
+		return d.unmarshalJSONNumber(data)
+	}
+
 	var dstr string
 	if err := json.Unmarshal(data, &dstr); err != nil {
 		return err
@@ -173,6 +253,31 @@ func (d *Duration) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (d *Duration) unmarshalJSONNumber(data []byte) error {
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return err
+	}
+	scale := DurationJSONUnit.scale()
+	if n, err := num.Int64(); err == nil {
+		if n > math.MaxInt64/int64(scale) || n < math.MinInt64/int64(scale) {
+			return fmt.Errorf("duration %s overflows", num)
+		}
+		*d = Duration(time.Duration(n) * scale)
+		return nil
+	}
+	f, err := num.Float64()
+	if err != nil {
+		return err
+	}
+	f *= float64(scale)
+	if math.IsNaN(f) || f >= math.MaxInt64 || f < math.MinInt64 {
+		return fmt.Errorf("duration %s overflows", num)
+	}
+	*d = Duration(int64(math.Round(f)))
+	return nil
+}
+
 func (d Duration) MarshalBSON() ([]byte, error) {
 	return bson.Marshal(bson.M{"data": d.String()})
 }
@@ -195,6 +300,54 @@ func (d *Duration) UnmarshalBSON(data []byte) error {
 	return errors.New("couldn't unmarshal bson bytes value as Date")
 }
 
+// MarshalBSONValue marshals this Duration as a BSON int64, in nanoseconds
+func (d Duration) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(int64(d))
+}
+
+// UnmarshalBSONValue reads this Duration from a BSON integer, in nanoseconds.
+//
+// BSON strings such as "3s" and documents such as {"data": "3s"}, as rendered by MarshalBSON, are accepted as well.
+func (d *Duration) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	raw := bson.RawValue{Type: tpe, Value: data}
+	switch tpe {
+	case bson.TypeNull:
+		*d = Duration(0)
+		return nil
+	case bson.TypeInt64:
+		if i64, ok := raw.Int64OK(); ok {
+			*d = Duration(i64)
+			return nil
+		}
+	case bson.TypeInt32:
+		if i32, ok := raw.Int32OK(); ok {
+			*d = Duration(i32)
+			return nil
+		}
+	case bson.TypeString:
+		if str, ok := raw.StringValueOK(); ok {
+			rd, err := ParseDuration(str)
+			if err != nil {
+				return err
+			}
+			*d = Duration(rd)
+			return nil
+		}
+	case bson.TypeEmbeddedDocument:
+		if doc, ok := raw.DocumentOK(); ok {
+			if str, ok := doc.Lookup("data").StringValueOK(); ok {
+				rd, err := ParseDuration(str)
+				if err != nil {
+					return err
+				}
+				*d = Duration(rd)
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("couldn't unmarshal bson value of type %s as Duration", tpe)
+}
+
 // DeepCopyInto copies the receiver and writes its value into out.
 func (d *Duration) DeepCopyInto(out *Duration) {
 	*out = *d
@@ -209,3 +362,53 @@ func (d *Duration) DeepCopy() *Duration {
 	d.DeepCopyInto(out)
 	return out
 }
+
+// Since returns the duration elapsed from other to d, i.e. d - other
+func (d Duration) Since(other Duration) Duration {
+	return d - other
+}
+
+// Abs returns the absolute value of d.
+//
+// As for time.Duration, the absolute value of the minimum duration is the maximum duration.
+func (d Duration) Abs() Duration {
+	return Duration(time.Duration(d).Abs())
+}
+
+// Neg returns -d
+func (d Duration) Neg() Duration {
+	return -d
+}
+
+// Max returns the longest of d and other
+func (d Duration) Max(other Duration) Duration {
+	if other > d {
+		return other
+	}
+	return d
+}
+
+// Min returns the shortest of d and other
+func (d Duration) Min(other Duration) Duration {
+	if other < d {
+		return other
+	}
+	return d
+}
+
+// Scale returns d multiplied by factor, rounded to the nearest nanosecond.
+//
+// The result is clamped to the range of durations when it overflows, and is zero when factor is NaN.
+func (d Duration) Scale(factor float64) Duration {
+	scaled := math.Round(float64(d) * factor)
+	switch {
+	case math.IsNaN(scaled):
+		return 0
+	case scaled >= math.MaxInt64:
+		return Duration(math.MaxInt64)
+	case scaled <= math.MinInt64:
+		return Duration(math.MinInt64)
+	default:
+		return Duration(scaled)
+	}
+}
@@ -15,6 +15,10 @@
 package strfmt
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"math"
 	"testing"
 	"time"
 
@@ -224,3 +228,122 @@ func TestDeepCopyDuration(t *testing.T) {
 	out3 := inNil.DeepCopy()
 	assert.Nil(t, out3)
 }
+
+func TestGobEncodingDuration(t *testing.T) {
+	for _, in := range []Duration{
+		Duration(0),
+		Duration(1),
+		Duration(3*time.Hour + 4*time.Minute + 5*time.Second + 123456789),
+		Duration(-time.Nanosecond),
+	} {
+		b := bytes.Buffer{}
+		require.NoError(t, gob.NewEncoder(&b).Encode(in))
+
+		var result Duration
+		require.NoError(t, gob.NewDecoder(&b).Decode(&result))
+		assert.Equal(t, in, result)
+	}
+}
+
+func TestDuration_JSONUnit(t *testing.T) {
+	t.Cleanup(func() { DurationJSONUnit = DurationString })
+
+	d := Duration(1500 * time.Millisecond)
+
+	DurationJSONUnit = DurationString
+	b, err := d.MarshalJSON()
+	require.NoError(t, err)
+	legacy, err := json.Marshal(time.Duration(d).String())
+	require.NoError(t, err)
+	assert.Equal(t, legacy, b)
+
+	for _, tc := range []struct {
+		unit DurationUnit
+		want string
+	}{
+		{DurationNanoseconds, "1500000000"},
+		{DurationMicroseconds, "1500000"},
+		{DurationMilliseconds, "1500"},
+		{DurationSeconds, "1.5"},
+	} {
+		DurationJSONUnit = tc.unit
+		b, err := d.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, string(b))
+
+		var back Duration
+		require.NoError(t, back.UnmarshalJSON(b))
+		assert.Equal(t, d, back)
+
+		back = 0
+		require.NoError(t, back.UnmarshalJSON([]byte(`"1500ms"`)))
+		assert.Equal(t, d, back)
+	}
+}
+
+func TestDuration_UnmarshalJSONNumber(t *testing.T) {
+	t.Cleanup(func() { DurationJSONUnit = DurationString })
+
+	var d Duration
+	require.NoError(t, d.UnmarshalJSON([]byte("42")))
+	assert.Equal(t, Duration(42), d)
+
+	DurationJSONUnit = DurationSeconds
+	require.NoError(t, d.UnmarshalJSON([]byte("3")))
+	assert.Equal(t, Duration(3*time.Second), d)
+
+	require.Error(t, d.UnmarshalJSON([]byte("1e300")))
+	require.Error(t, d.UnmarshalJSON([]byte("10000000000000")))
+	require.Error(t, d.UnmarshalJSON([]byte("true")))
+}
+
+func TestDuration_Arithmetic(t *testing.T) {
+	d := Duration(90 * time.Second)
+	other := Duration(2 * time.Minute)
+
+	assert.Equal(t, Duration(-30*time.Second), d.Since(other))
+	assert.Equal(t, Duration(30*time.Second), other.Since(d))
+	assert.Equal(t, Duration(30*time.Second), d.Since(other).Abs())
+	assert.Equal(t, d, d.Abs())
+	assert.Equal(t, Duration(math.MaxInt64), Duration(math.MinInt64).Abs())
+	assert.Equal(t, Duration(-90*time.Second), d.Neg())
+	assert.Equal(t, other, d.Max(other))
+	assert.Equal(t, other, other.Max(d))
+	assert.Equal(t, d, d.Min(other))
+	assert.Equal(t, d, other.Min(d))
+
+	assert.Equal(t, Duration(135*time.Second), d.Scale(1.5))
+	assert.Equal(t, Duration(-45*time.Second), d.Scale(-0.5))
+	assert.Equal(t, Duration(0), d.Scale(0))
+	assert.Equal(t, Duration(math.MaxInt64), d.Scale(math.Inf(1)))
+	assert.Equal(t, Duration(math.MinInt64), d.Scale(-1e300))
+	assert.Equal(t, Duration(0), d.Scale(math.NaN()))
+}
+
+func TestDuration_MarshalJSONZeroAsNull(t *testing.T) {
+	t.Cleanup(func() { DurationMarshalZeroAsNull = false })
+
+	assert.True(t, Duration(0).IsZero())
+	assert.False(t, Duration(time.Second).IsZero())
+
+	b, err := json.Marshal(Duration(0))
+	require.NoError(t, err)
+	assert.Equal(t, `"0s"`, string(b))
+
+	b, err = Duration(0).MarshalJSONOrNull()
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = Duration(time.Minute).MarshalJSONOrNull()
+	require.NoError(t, err)
+	assert.Equal(t, `"1m0s"`, string(b))
+
+	DurationMarshalZeroAsNull = true
+	b, err = json.Marshal(Duration(0))
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = json.Marshal(Duration(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, `"-1s"`, string(b))
+}
@@ -0,0 +1,191 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxEmailLength is the maximum length of an email address, as per RFC 5321 (errata 1690)
+	maxEmailLength = 254
+
+	// maxEmailLocalPartLength is the maximum length of the local part of an email address, as per RFC 5321
+	maxEmailLocalPartLength = 64
+)
+
+// Errors reported by ValidateEmail, as unwrapped from an *EmailValidationError
+var (
+	ErrEmailMissingAtSign    = errors.New("missing @ sign")
+	ErrEmailInvalidLocalPart = errors.New("invalid local part")
+	ErrEmailInvalidDomain    = errors.New("invalid domain")
+	ErrEmailTooLong          = errors.New("too long")
+)
+
+// EmailValidationError explains why a string is not a valid email address
+type EmailValidationError struct {
+	Value string
+
+	MissingAtSign    bool
+	InvalidLocalPart bool
+	InvalidDomain    bool
+	TooLong          bool
+
+	// err is the error reported by net/mail, when none of the rules above explains the failure
+	err error
+}
+
+// Error describes the first failure
+func (e *EmailValidationError) Error() string {
+	errs := e.Unwrap()
+	if len(errs) == 0 {
+		return fmt.Sprintf("%q is not a valid email address", e.Value)
+	}
+	return fmt.Sprintf("%q is not a valid email address: %v", e.Value, errs[0])
+}
+
+// Unwrap returns the failures as a slice of errors, to support errors.Is and errors.As
+// (e.g. errors.Is(err, ErrEmailInvalidDomain))
+func (e *EmailValidationError) Unwrap() []error {
+	var errs []error
+	if e.MissingAtSign {
+		errs = append(errs, ErrEmailMissingAtSign)
+	}
+	if e.InvalidLocalPart {
+		errs = append(errs, ErrEmailInvalidLocalPart)
+	}
+	if e.InvalidDomain {
+		errs = append(errs, ErrEmailInvalidDomain)
+	}
+	if e.TooLong {
+		errs = append(errs, ErrEmailTooLong)
+	}
+	if len(errs) == 0 && e.err != nil {
+		errs = append(errs, e.err)
+	}
+	return errs
+}
+
+// ValidateEmail validates an email address, and explains why it is not valid.
+//
+// It returns nil when the address is valid, and an *EmailValidationError otherwise.
+// Unlike IsEmail, ValidateEmail also rejects addresses longer than 254 characters,
+// or with a local part longer than 64 characters, as per RFC 5321.
+func ValidateEmail(str string) error {
+	addr, err := mail.ParseAddress(str)
+	if err == nil && addr.Address != "" {
+		local, _, _ := cutLastAt(addr.Address)
+		if len(addr.Address) <= maxEmailLength && len(local) <= maxEmailLocalPartLength {
+			return nil
+		}
+		return &EmailValidationError{Value: str, TooLong: true}
+	}
+
+	verr := &EmailValidationError{Value: str, err: err}
+
+	// extract the address from a "Name <address>" form
+	spec := strings.TrimSpace(str)
+	if open := strings.LastIndexByte(spec, '<'); open >= 0 && strings.HasSuffix(spec, ">") {
+		spec = spec[open+1 : len(spec)-1]
+	}
+
+	local, domain, found := cutLastAt(spec)
+	if !found {
+		verr.MissingAtSign = true
+	} else {
+		verr.InvalidLocalPart = local == "" || !isEmailAddress(local+"@example.com")
+		verr.InvalidDomain = domain == "" || !isEmailAddress("local@"+domain)
+	}
+	verr.TooLong = len(spec) > maxEmailLength || len(local) > maxEmailLocalPartLength
+
+	return verr
+}
+
+// validateEmailFormat validates the "email" format: it accepts the same addresses as IsEmail,
+// and explains failures with ValidateEmail
+func validateEmailFormat(_ context.Context, str string) error {
+	if IsEmail(str) {
+		return nil
+	}
+	return ValidateEmail(str)
+}
+
+// Validate validates this email address with ValidateEmail
+func (e Email) Validate() error {
+	return ValidateEmail(string(e))
+}
+
+// cutLastAt splits an email address around its last @ sign
+func cutLastAt(addr string) (local, domain string, found bool) {
+	at := strings.LastIndexByte(addr, '@')
+	if at < 0 {
+		return "", "", false
+	}
+	return addr[:at], addr[at+1:], true
+}
+
+// isEmailAddress checks a bare email address with net/mail
+func isEmailAddress(addr string) bool {
+	_, err := mail.ParseAddress(addr)
+	return err == nil
+}
+
+// IsIDN returns true when the domain part of this email address contains non-ASCII characters,
+// i.e. when it is an internationalized domain name in its Unicode form
+func (e Email) IsIDN() bool {
+	_, domain, found := cutLastAt(string(e))
+	if !found {
+		return false
+	}
+	for i := 0; i < len(domain); i++ {
+		if domain[i] >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
+
+// ToIDN returns this email address with its domain part converted to its ASCII (Punycode) form,
+// e.g. "juan@xn--bcher-kva.de" for "juan@bücher.de". The local part is left unchanged.
+//
+// An error is returned when this email address has no domain part or when the domain cannot be converted.
+func (e Email) ToIDN() (Email, error) {
+	return e.convertDomain(idnaProfile.ToASCII)
+}
+
+// ToUnicode returns this email address with its domain part converted to its Unicode form,
+// e.g. "juan@bücher.de" for "juan@xn--bcher-kva.de". The local part is left unchanged.
+//
+// An error is returned when this email address has no domain part or when the domain cannot be converted.
+func (e Email) ToUnicode() (Email, error) {
+	return e.convertDomain(idnaProfile.ToUnicode)
+}
+
+func (e Email) convertDomain(convert func(string) (string, error)) (Email, error) {
+	local, domain, found := cutLastAt(string(e))
+	if !found || local == "" || domain == "" {
+		return "", fmt.Errorf("%q is not an email address with a domain part", string(e))
+	}
+	converted, err := convert(domain)
+	if err != nil {
+		return "", fmt.Errorf("cannot convert the domain of email address %q: %w", string(e), err)
+	}
+	return Email(local + "@" + converted), nil
+}
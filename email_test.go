@@ -0,0 +1,137 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEmail(t *testing.T) {
+	for _, valid := range []string{
+		"somebody@example.com",
+		"Some Body <somebody@example.com>",
+		"first.last+tag@sub.example.com",
+		strings.Repeat("a", 64) + "@example.com",
+	} {
+		require.NoErrorf(t, ValidateEmail(valid), "expected %q to be valid", valid)
+		require.NoError(t, Email(valid).Validate())
+		assert.True(t, IsEmail(valid))
+	}
+
+	for _, tc := range []struct {
+		email    string
+		expected EmailValidationError
+		first    error
+	}{
+		{"somebody.example.com", EmailValidationError{MissingAtSign: true}, ErrEmailMissingAtSign},
+		{"Some Body <somebody.example.com>", EmailValidationError{MissingAtSign: true}, ErrEmailMissingAtSign},
+		{"@example.com", EmailValidationError{InvalidLocalPart: true}, ErrEmailInvalidLocalPart},
+		{"some body@example.com", EmailValidationError{InvalidLocalPart: true}, ErrEmailInvalidLocalPart},
+		{"somebody@", EmailValidationError{InvalidDomain: true}, ErrEmailInvalidDomain},
+		{"somebody@exa mple.com", EmailValidationError{InvalidDomain: true}, ErrEmailInvalidDomain},
+		{"some(body@exa mple.com", EmailValidationError{InvalidLocalPart: true, InvalidDomain: true}, ErrEmailInvalidLocalPart},
+		{strings.Repeat("a", 65) + "@example.com", EmailValidationError{TooLong: true}, ErrEmailTooLong},
+		{"somebody@" + strings.Repeat("a", 250) + ".com", EmailValidationError{TooLong: true}, ErrEmailTooLong},
+		{strings.Repeat("a", 65) + "@exa mple.com", EmailValidationError{InvalidDomain: true, TooLong: true}, ErrEmailInvalidDomain},
+	} {
+		err := ValidateEmail(tc.email)
+		var verr *EmailValidationError
+		require.ErrorAsf(t, err, &verr, "expected %q to be invalid", tc.email)
+		assert.Equal(t, tc.email, verr.Value)
+		assert.Equalf(t, tc.expected.MissingAtSign, verr.MissingAtSign, "MissingAtSign for %q", tc.email)
+		assert.Equalf(t, tc.expected.InvalidLocalPart, verr.InvalidLocalPart, "InvalidLocalPart for %q", tc.email)
+		assert.Equalf(t, tc.expected.InvalidDomain, verr.InvalidDomain, "InvalidDomain for %q", tc.email)
+		assert.Equalf(t, tc.expected.TooLong, verr.TooLong, "TooLong for %q", tc.email)
+
+		assert.ErrorIs(t, err, tc.first)
+		assert.Equal(t, tc.first, verr.Unwrap()[0])
+		assert.Contains(t, err.Error(), tc.first.Error())
+		require.Error(t, Email(tc.email).Validate())
+		// length limits are only enforced by ValidateEmail
+		assert.Equal(t, tc.first == ErrEmailTooLong, IsEmail(tc.email))
+	}
+
+	// failures not explained by a rule are still reported
+	err := ValidateEmail(`"unterminated <somebody@example.com>`)
+	require.Error(t, err)
+	var verr *EmailValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Len(t, verr.Unwrap(), 1)
+	assert.False(t, errors.Is(err, ErrEmailMissingAtSign))
+}
+
+func TestValidateEmail_Registry(t *testing.T) {
+	err := asFormatRegistry(t, Default).ValidateWithContext(context.Background(), "email", "somebody@")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrEmailInvalidDomain)
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "email", verr.Format)
+
+	require.NoError(t, asFormatRegistry(t, Default).ValidateWithContext(context.Background(), "email", "somebody@example.com"))
+	// the email format accepts the same addresses as IsEmail
+	assert.True(t, Default.Validates("email", strings.Repeat("a", 65)+"@example.com"))
+	require.NoError(t, asFormatRegistry(t, Default).ValidateWithContext(context.Background(), "email", strings.Repeat("a", 65)+"@example.com"))
+
+	registry := asFormatRegistry(t, NewFormats())
+	registry.Reset()
+	assert.ErrorIs(t, registry.ValidateWithContext(context.Background(), "email", "example.com"), ErrEmailMissingAtSign)
+}
+
+func TestEmail_IDN(t *testing.T) {
+	for _, tc := range []struct {
+		unicode Email
+		ascii   Email
+	}{
+		{unicode: "用户@例子.广告", ascii: "用户@xn--fsqu00a.xn--4rr70v"},
+		{unicode: "квіточка@пошта.укр", ascii: "квіточка@xn--80a1acn3a.xn--j1amh"},
+		{unicode: "θσερ@εχαμπλε.ψομ", ascii: "θσερ@xn--mxahbxey0c.xn--xxaf0a"},
+		{unicode: "ชื่อ@ไทย.ไทย", ascii: "ชื่อ@xn--o3cw4h.xn--o3cw4h"},
+		{unicode: "juan@bücher.de", ascii: "juan@xn--bcher-kva.de"},
+		{unicode: "user@example.com", ascii: "user@example.com"},
+	} {
+		ascii, err := tc.unicode.ToIDN()
+		require.NoError(t, err)
+		assert.Equal(t, tc.ascii, ascii)
+
+		unicode, err := tc.ascii.ToUnicode()
+		require.NoError(t, err)
+		assert.Equal(t, tc.unicode, unicode)
+
+		assert.Equal(t, tc.unicode != tc.ascii, tc.unicode.IsIDN())
+		assert.False(t, tc.ascii.IsIDN())
+	}
+
+	// the local part is left unchanged, the domain is mapped to lower case
+	ascii, err := Email("Juan.Pérez@Bücher.DE").ToIDN()
+	require.NoError(t, err)
+	assert.Equal(t, Email("Juan.Pérez@xn--bcher-kva.de"), ascii)
+	assert.False(t, Email("Juan.Pérez@example.com").IsIDN())
+
+	for _, invalid := range []Email{"", "no-at-sign", "@bücher.de", "juan@", "juan@xn--a.de", "juan@bücher..de"} {
+		_, err = invalid.ToIDN()
+		require.Errorf(t, err, "expected %q not to convert to ASCII", invalid)
+	}
+	for _, invalid := range []Email{"", "no-at-sign", "@xn--bcher-kva.de", "juan@", "juan@xn--a.de"} {
+		_, err = invalid.ToUnicode()
+		require.Errorf(t, err, "expected %q not to convert to Unicode", invalid)
+	}
+}
@@ -0,0 +1,404 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	// register formats in the default registry:
+	//   - epoch
+	//   - epoch-millis
+	//   - epoch-nanos
+	e := Epoch(0)
+	addBuiltin("epoch", &e, IsEpoch)
+
+	em := EpochMillis(0)
+	addBuiltin("epoch-millis", &em, IsEpoch)
+
+	en := EpochNanos(0)
+	addBuiltin("epoch-nanos", &en, IsEpoch)
+}
+
+// IsEpoch returns true when the string is a decimal integer, suitable for any of the epoch formats
+func IsEpoch(str string) bool {
+	_, err := parseEpoch(str)
+	return err == nil
+}
+
+// parseEpoch parses a decimal (possibly negative) 64-bit integer
+func parseEpoch(str string) (int64, error) {
+	if str == "" || str[0] == '+' {
+		return 0, fmt.Errorf("invalid epoch: %q", str)
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid epoch: %q: %w", str, err)
+	}
+	return v, nil
+}
+
+// Epoch represents a unix timestamp, as a number of seconds elapsed since January 1, 1970 UTC
+//
+// swagger:strfmt epoch
+type Epoch int64
+
+// ToTime converts this timestamp to a UTC time
+func (e Epoch) ToTime() time.Time {
+	return time.Unix(int64(e), 0).UTC()
+}
+
+// ToDateTime converts this timestamp to a UTC DateTime
+func (e Epoch) ToDateTime() DateTime {
+	return DateTime(e.ToTime())
+}
+
+// MarshalText turns this instance into text
+func (e Epoch) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (e *Epoch) UnmarshalText(data []byte) error {
+	v, err := parseEpoch(string(data))
+	if err != nil {
+		return err
+	}
+	*e = Epoch(v)
+	return nil
+}
+
+// Scan reads a Epoch value from database driver type.
+func (e *Epoch) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case int64:
+		*e = Epoch(v)
+	case []byte:
+		return e.UnmarshalText(v)
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case nil:
+		*e = Epoch(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Epoch from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts Epoch to a primitive value ready to be written to a database.
+func (e Epoch) Value() (driver.Value, error) {
+	return driver.Value(int64(e)), nil
+}
+
+// String converts this timestamp to a decimal string
+func (e Epoch) String() string {
+	return strconv.FormatInt(int64(e), 10)
+}
+
+// MarshalJSON returns the Epoch as a JSON number
+func (e Epoch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(e))
+}
+
+// UnmarshalJSON sets the Epoch from a JSON number
+func (e *Epoch) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	var v int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = Epoch(v)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (e Epoch) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": int64(e)})
+}
+
+// UnmarshalBSON document into this value
+func (e *Epoch) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	switch v := m["data"].(type) {
+	case int64:
+		*e = Epoch(v)
+	case int32:
+		*e = Epoch(v)
+	default:
+		return errors.New("couldn't unmarshal bson bytes value as Epoch")
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (e *Epoch) DeepCopyInto(out *Epoch) {
+	*out = *e
+}
+
+// DeepCopy copies the receiver into a new Epoch.
+func (e *Epoch) DeepCopy() *Epoch {
+	if e == nil {
+		return nil
+	}
+	out := new(Epoch)
+	e.DeepCopyInto(out)
+	return out
+}
+
+// EpochMillis represents a unix timestamp, as a number of milliseconds elapsed since January 1, 1970 UTC
+//
+// swagger:strfmt epoch-millis
+type EpochMillis int64
+
+// ToTime converts this timestamp to a UTC time
+func (e EpochMillis) ToTime() time.Time {
+	return time.UnixMilli(int64(e)).UTC()
+}
+
+// ToDateTime converts this timestamp to a UTC DateTime
+func (e EpochMillis) ToDateTime() DateTime {
+	return DateTime(e.ToTime())
+}
+
+// MarshalText turns this instance into text
+func (e EpochMillis) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (e *EpochMillis) UnmarshalText(data []byte) error {
+	v, err := parseEpoch(string(data))
+	if err != nil {
+		return err
+	}
+	*e = EpochMillis(v)
+	return nil
+}
+
+// Scan reads a EpochMillis value from database driver type.
+func (e *EpochMillis) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case int64:
+		*e = EpochMillis(v)
+	case []byte:
+		return e.UnmarshalText(v)
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case nil:
+		*e = EpochMillis(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.EpochMillis from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts EpochMillis to a primitive value ready to be written to a database.
+func (e EpochMillis) Value() (driver.Value, error) {
+	return driver.Value(int64(e)), nil
+}
+
+// String converts this timestamp to a decimal string
+func (e EpochMillis) String() string {
+	return strconv.FormatInt(int64(e), 10)
+}
+
+// MarshalJSON returns the EpochMillis as a JSON number
+func (e EpochMillis) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(e))
+}
+
+// UnmarshalJSON sets the EpochMillis from a JSON number
+func (e *EpochMillis) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	var v int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = EpochMillis(v)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (e EpochMillis) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": int64(e)})
+}
+
+// UnmarshalBSON document into this value
+func (e *EpochMillis) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	switch v := m["data"].(type) {
+	case int64:
+		*e = EpochMillis(v)
+	case int32:
+		*e = EpochMillis(v)
+	default:
+		return errors.New("couldn't unmarshal bson bytes value as EpochMillis")
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (e *EpochMillis) DeepCopyInto(out *EpochMillis) {
+	*out = *e
+}
+
+// DeepCopy copies the receiver into a new EpochMillis.
+func (e *EpochMillis) DeepCopy() *EpochMillis {
+	if e == nil {
+		return nil
+	}
+	out := new(EpochMillis)
+	e.DeepCopyInto(out)
+	return out
+}
+
+// EpochNanos represents a unix timestamp, as a number of nanoseconds elapsed since January 1, 1970 UTC
+//
+// swagger:strfmt epoch-nanos
+type EpochNanos int64
+
+// ToTime converts this timestamp to a UTC time
+func (e EpochNanos) ToTime() time.Time {
+	return time.Unix(0, int64(e)).UTC()
+}
+
+// ToDateTime converts this timestamp to a UTC DateTime
+func (e EpochNanos) ToDateTime() DateTime {
+	return DateTime(e.ToTime())
+}
+
+// MarshalText turns this instance into text
+func (e EpochNanos) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (e *EpochNanos) UnmarshalText(data []byte) error {
+	v, err := parseEpoch(string(data))
+	if err != nil {
+		return err
+	}
+	*e = EpochNanos(v)
+	return nil
+}
+
+// Scan reads a EpochNanos value from database driver type.
+func (e *EpochNanos) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case int64:
+		*e = EpochNanos(v)
+	case []byte:
+		return e.UnmarshalText(v)
+	case string:
+		return e.UnmarshalText([]byte(v))
+	case nil:
+		*e = EpochNanos(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.EpochNanos from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts EpochNanos to a primitive value ready to be written to a database.
+func (e EpochNanos) Value() (driver.Value, error) {
+	return driver.Value(int64(e)), nil
+}
+
+// String converts this timestamp to a decimal string
+func (e EpochNanos) String() string {
+	return strconv.FormatInt(int64(e), 10)
+}
+
+// MarshalJSON returns the EpochNanos as a JSON number
+func (e EpochNanos) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(e))
+}
+
+// UnmarshalJSON sets the EpochNanos from a JSON number
+func (e *EpochNanos) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	var v int64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = EpochNanos(v)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (e EpochNanos) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": int64(e)})
+}
+
+// UnmarshalBSON document into this value
+func (e *EpochNanos) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	switch v := m["data"].(type) {
+	case int64:
+		*e = EpochNanos(v)
+	case int32:
+		*e = EpochNanos(v)
+	default:
+		return errors.New("couldn't unmarshal bson bytes value as EpochNanos")
+	}
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (e *EpochNanos) DeepCopyInto(out *EpochNanos) {
+	*out = *e
+}
+
+// DeepCopy copies the receiver into a new EpochNanos.
+func (e *EpochNanos) DeepCopy() *EpochNanos {
+	if e == nil {
+		return nil
+	}
+	out := new(EpochNanos)
+	e.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,204 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	_ sql.Scanner   = new(Epoch)
+	_ driver.Valuer = Epoch(0)
+	_ sql.Scanner   = new(EpochMillis)
+	_ driver.Valuer = EpochMillis(0)
+	_ sql.Scanner   = new(EpochNanos)
+	_ driver.Valuer = EpochNanos(0)
+)
+
+func TestEpoch(t *testing.T) {
+	var e Epoch
+
+	require.NoError(t, e.UnmarshalText([]byte("1700000000")))
+	assert.Equal(t, Epoch(1700000000), e)
+	require.Error(t, e.UnmarshalText([]byte("yada")))
+	require.Error(t, e.UnmarshalText([]byte("1.5")))
+	require.Error(t, e.UnmarshalText([]byte("")))
+
+	txt, err := e.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "1700000000", string(txt))
+	assert.Equal(t, "1700000000", e.String())
+
+	require.NoError(t, e.UnmarshalJSON([]byte("-86400")))
+	assert.Equal(t, Epoch(-86400), e)
+	require.Error(t, e.UnmarshalJSON([]byte(`"86400"`)))
+	require.Error(t, e.UnmarshalJSON([]byte("1.5")))
+	require.NoError(t, e.UnmarshalJSON([]byte(jsonNull)))
+	assert.Equal(t, Epoch(-86400), e)
+
+	b, err := e.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("-86400"), b)
+
+	bsonData, err := bson.Marshal(&e)
+	require.NoError(t, err)
+
+	var eCopy Epoch
+	require.NoError(t, bson.Unmarshal(bsonData, &eCopy))
+	assert.Equal(t, e, eCopy)
+
+	bsonData, err = bson.Marshal(bson.M{"data": int32(42)})
+	require.NoError(t, err)
+	require.NoError(t, bson.Unmarshal(bsonData, &eCopy))
+	assert.Equal(t, Epoch(42), eCopy)
+
+	bsonData, err = bson.Marshal(bson.M{"data": "42"})
+	require.NoError(t, err)
+	require.Error(t, bson.Unmarshal(bsonData, &eCopy))
+}
+
+func TestEpoch_Scan(t *testing.T) {
+	for _, value := range []interface{}{int64(1700000000), "1700000000", []byte("1700000000")} {
+		var e Epoch
+		require.NoError(t, e.Scan(value))
+		assert.Equal(t, Epoch(1700000000), e)
+
+		var em EpochMillis
+		require.NoError(t, em.Scan(value))
+		assert.Equal(t, EpochMillis(1700000000), em)
+
+		var en EpochNanos
+		require.NoError(t, en.Scan(value))
+		assert.Equal(t, EpochNanos(1700000000), en)
+	}
+
+	var e Epoch
+	require.NoError(t, e.Scan(nil))
+	assert.Equal(t, Epoch(0), e)
+	require.Error(t, e.Scan("now"))
+	require.Error(t, e.Scan(1.5))
+
+	v, err := Epoch(42).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+	v, err = EpochMillis(42).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+	v, err = EpochNanos(42).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), v)
+}
+
+func TestEpoch_ToDateTime(t *testing.T) {
+	expected := time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC)
+	assert.Equal(t, expected, Epoch(1700000000).ToTime())
+	assert.Equal(t, DateTime(expected), Epoch(1700000000).ToDateTime())
+
+	expected = time.Date(2023, time.November, 14, 22, 13, 20, 123000000, time.UTC)
+	assert.Equal(t, expected, EpochMillis(1700000000123).ToTime())
+	assert.Equal(t, DateTime(expected), EpochMillis(1700000000123).ToDateTime())
+
+	expected = time.Date(2023, time.November, 14, 22, 13, 20, 123456789, time.UTC)
+	assert.Equal(t, expected, EpochNanos(1700000000123456789).ToTime())
+	assert.Equal(t, DateTime(expected), EpochNanos(1700000000123456789).ToDateTime())
+
+	assert.Equal(t, time.Date(1969, time.December, 31, 0, 0, 0, 0, time.UTC), Epoch(-86400).ToTime())
+}
+
+func TestEpoch_TimezoneNeutral(t *testing.T) {
+	defaultLocal := time.Local
+	t.Cleanup(func() { time.Local = defaultLocal })
+	time.Local = time.FixedZone("UTC+9", 9*60*60)
+
+	for _, tm := range []time.Time{
+		Epoch(0).ToTime(),
+		EpochMillis(0).ToTime(),
+		EpochNanos(0).ToTime(),
+		time.Time(Epoch(0).ToDateTime()),
+	} {
+		assert.Equal(t, time.UTC, tm.Location())
+		assert.Equal(t, 1970, tm.Year())
+		assert.Equal(t, 0, tm.Hour())
+	}
+	assert.Equal(t, "1970-01-01T00:00:00.000Z", Epoch(0).ToDateTime().String())
+}
+
+func TestIsEpoch(t *testing.T) {
+	for _, format := range []string{"epoch", "epoch-millis", "epoch-nanos"} {
+		for _, valid := range []string{"0", "1700000000", "-1", "9223372036854775807", "-9223372036854775808"} {
+			assert.Truef(t, IsEpoch(valid), "expected %q to be a valid epoch", valid)
+			testValid(t, format, valid)
+		}
+		for _, invalid := range []string{"", "+1", "1.5", "1e9", " 1", "0x10", "9223372036854775808", "now"} {
+			assert.Falsef(t, IsEpoch(invalid), "expected %q to be an invalid epoch", invalid)
+			testInvalid(t, format, invalid)
+		}
+	}
+}
+
+func TestDeepCopyEpoch(t *testing.T) {
+	e := Epoch(1700000000)
+	in := &e
+
+	out := new(Epoch)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *Epoch
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestDeepCopyEpochMillis(t *testing.T) {
+	e := EpochMillis(1700000000123)
+	in := &e
+
+	out := new(EpochMillis)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *EpochMillis
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestDeepCopyEpochNanos(t *testing.T) {
+	e := EpochNanos(1700000000123456789)
+	in := &e
+
+	out := new(EpochNanos)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *EpochNanos
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
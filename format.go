@@ -15,10 +15,15 @@
 package strfmt
 
 import (
+	"bytes"
+	"context"
 	"encoding"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"io"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -33,6 +38,12 @@ var Default = NewSeededFormats(nil, nil)
 // Validator represents a validator for a string format.
 type Validator func(string) bool
 
+// ContextualValidator represents a validator for a string format which depends on a context,
+// e.g. on a policy or a locale carried as a context value.
+//
+// It returns nil when the value is valid, and an error explaining why it is not otherwise.
+type ContextualValidator func(ctx context.Context, value string) error
+
 // Format represents a string format.
 //
 // All implementations of Format provide a string representation and text
@@ -44,6 +55,13 @@ type Format interface {
 }
 
 // Registry is a registry of string formats, with a validation method.
+//
+// The registries created by this package implement FormatRegistry as well, which groups the optional
+// interfaces of the extended registry features: assert a Registry to one of them to use these features, e.g.
+//
+//	if adder, ok := registry.(strfmt.TryAdder); ok {
+//		return adder.TryAdd("my-format", new(MyFormat), isMyFormat)
+//	}
 type Registry interface {
 	Add(string, Format, Validator) bool
 	DelByName(string) bool
@@ -54,25 +72,173 @@ type Registry interface {
 	MapStructureHookFunc() mapstructure.DecodeHookFunc
 }
 
+// TryAdder is a Registry which reports why a format cannot be registered
+type TryAdder interface {
+	TryAdd(string, Format, Validator) error
+}
+
+// ErrorValidator is a Registry which explains why a value is not valid
+type ErrorValidator interface {
+	ValidateWithError(string, string) *ValidationError
+}
+
+// ContextualRegistry is a Registry with formats validated by a ContextualValidator
+type ContextualRegistry interface {
+	AddContextual(string, Format, ContextualValidator) bool
+	ValidateWithContext(context.Context, string, string) error
+}
+
+// DescribingRegistry is a Registry with formats documented by a FormatDescription
+type DescribingRegistry interface {
+	AddWithDescription(string, Format, Validator, FormatDescription) bool
+	Describe(string) (FormatDescription, error)
+	AllDescriptions() []FormatDescription
+}
+
+// AliasResolver is a Registry which resolves the names and aliases of its formats
+type AliasResolver interface {
+	CanonicalName(string) (string, bool)
+	Aliases(string) []string
+}
+
+// RegistryInspector is a Registry which tells how many formats are registered
+type RegistryInspector interface {
+	HasValidator(string) bool
+	CountFormats() int
+	IsEmpty() bool
+}
+
+// RegistrySerializer is a Registry which saves and loads the names of its formats as JSON
+type RegistrySerializer interface {
+	SaveToJSON(io.Writer) error
+	LoadFromJSON(io.Reader, Registry) error
+}
+
+// RegistryMerger is a Registry which copies the formats of another Registry
+type RegistryMerger interface {
+	Merge(Registry) error
+	MergeOverride(Registry) error
+}
+
+// StrictRegistry is a Registry with a strict mode, rejecting unknown format names
+type StrictRegistry interface {
+	SetStrict(bool)
+	OnUnknownFormat(func(string))
+}
+
+// ResettableRegistry is a Registry which may be cleared, reset to the built-in formats, or restored from a snapshot
+type ResettableRegistry interface {
+	UnregisterAll()
+	Reset()
+	Snapshot() *RegistrySnapshot
+	Restore(*RegistrySnapshot)
+}
+
+// CodecRegistry is a Registry which serializes format values with pluggable codecs
+type CodecRegistry interface {
+	SetEncoder(FormatEncoder)
+	SetDecoder(FormatDecoder)
+	Encode(string, Format) ([]byte, error)
+	Decode(string, []byte) (interface{}, error)
+}
+
+// FormatRegistry groups the Registry and all its optional interfaces, as implemented by the registries of this package
+type FormatRegistry interface {
+	Registry
+	TryAdder
+	ErrorValidator
+	ContextualRegistry
+	DescribingRegistry
+	AliasResolver
+	RegistryInspector
+	RegistrySerializer
+	RegistryMerger
+	StrictRegistry
+	ResettableRegistry
+	CodecRegistry
+}
+
+var _ FormatRegistry = &defaultFormats{}
+
+// builtinFormat records a format registered by this package
+type builtinFormat struct {
+	name        string
+	format      Format
+	validator   Validator
+	contextual  ContextualValidator
+	description FormatDescription
+}
+
+// register adds this built-in format to a registry
+func (b builtinFormat) register(f *defaultFormats) {
+	if b.contextual != nil {
+		f.AddContextual(b.name, b.format, b.contextual)
+		f.setDescription(b.name, b.description)
+		return
+	}
+	f.AddWithDescription(b.name, b.format, b.validator, b.description)
+}
+
+// builtinFormats are the formats registered in the Default registry by this package, in registration order
+var builtinFormats []builtinFormat
+
+// addBuiltin registers a format of this package in the Default registry, and records it for Reset
+func addBuiltin(name string, strfmt Format, validator Validator) {
+	builtin := builtinFormat{name: name, format: strfmt, validator: validator, description: builtinDescriptions[name]}
+	builtinFormats = append(builtinFormats, builtin)
+	//nolint:forcetypeassert
+	builtin.register(Default.(*defaultFormats))
+}
+
+// addBuiltinContextual registers a format of this package validated by a ContextualValidator, like addBuiltin
+func addBuiltinContextual(name string, strfmt Format, validator ContextualValidator) {
+	builtin := builtinFormat{name: name, format: strfmt, contextual: validator, description: builtinDescriptions[name]}
+	builtinFormats = append(builtinFormats, builtin)
+	//nolint:forcetypeassert
+	builtin.register(Default.(*defaultFormats))
+}
+
 type knownFormat struct {
 	Name      string
 	OrigName  string
 	Type      reflect.Type
 	Validator Validator
+
+	// ContextualValidator is set when the format has been registered with AddContextual
+	ContextualValidator ContextualValidator
+
+	// Aliases are the other spellings this format has been registered with
+	Aliases []string
+
+	// Description documents the format, when registered with AddWithDescription
+	Description FormatDescription
 }
 
 // NameNormalizer is a function that normalizes a format name.
+//
+// Registries resolve format names by comparing their normalized forms: all names with the same
+// normalized form are aliases of the same format.
 type NameNormalizer func(string) string
 
-// DefaultNameNormalizer removes all dashes
+// nameSeparators are removed from format names by the DefaultNameNormalizer
+var nameSeparators = strings.NewReplacer("-", "", "_", "")
+
+// DefaultNameNormalizer removes all dashes and underscores, and lower cases the name.
+//
+// This is the normalization rule of the registries created with a nil NameNormalizer:
+// e.g. "date-time", "date_time", "datetime" and "DateTime" all resolve to the same format.
 func DefaultNameNormalizer(name string) string {
-	return strings.ReplaceAll(name, "-", "")
+	return strings.ToLower(nameSeparators.Replace(name))
 }
 
 type defaultFormats struct {
 	sync.Mutex
 	data          []knownFormat
 	normalizeName NameNormalizer
+	strict        bool
+	onUnknown     func(string)
+	encoder       FormatEncoder
+	decoder       FormatDecoder
 }
 
 // NewFormats creates a new formats registry seeded with the values from the default
@@ -94,10 +260,22 @@ func NewSeededFormats(seeds []knownFormat, normalizer NameNormalizer) Registry {
 	}
 }
 
-// MapStructureHookFunc is a decode hook function for mapstructure
+// MapStructureHookFunc is a decode hook function for mapstructure.
+//
+// Pointers to formats (e.g. *DateTime) are decoded as their pointed-to format.
+//
+// time.Time values (and pointers to time.Time) are decoded as Date or DateTime.
+//
+// Decoding errors are reported as *ValidationError, wrapping the underlying parsing error.
+// Note that mapstructure.Decoder flattens the errors returned by hooks into its own error messages.
 func (f *defaultFormats) MapStructureHookFunc() mapstructure.DecodeHookFunc {
-	return func(from reflect.Type, to reflect.Type, obj interface{}) (interface{}, error) {
-		if from.Kind() != reflect.String {
+	var hook mapstructure.DecodeHookFuncType
+	hook = func(from reflect.Type, to reflect.Type, obj interface{}) (interface{}, error) {
+		if from == timeType || from == reflect.PointerTo(timeType) {
+			return f.decodeTime(to, obj), nil
+		}
+		if from.Kind() != reflect.String || from == to {
+			// values already of the target type (e.g. the elements of a decoded EmailList) are kept as is
 			return obj, nil
 		}
 		data, ok := obj.(string)
@@ -105,88 +283,312 @@ func (f *defaultFormats) MapStructureHookFunc() mapstructure.DecodeHookFunc {
 			return nil, fmt.Errorf("failed to cast %+v to string", obj)
 		}
 
+		if to.Kind() == reflect.Ptr && f.containsType(to.Elem()) {
+			decoded, err := hook(from, to.Elem(), obj)
+			if err != nil {
+				return nil, err
+			}
+			ptr := reflect.New(to.Elem())
+			ptr.Elem().Set(reflect.ValueOf(decoded))
+			return ptr.Interface(), nil
+		}
+
 		for _, v := range f.data {
 			tpe, _ := f.GetType(v.Name)
 			if to == tpe {
-				switch v.Name {
-				case "date":
-					d, err := time.ParseInLocation(RFC3339FullDate, data, DefaultTimeLocation)
-					if err != nil {
-						return nil, err
-					}
-					return Date(d), nil
-				case "datetime":
-					input := data
-					if len(input) == 0 {
-						return nil, stderrors.New("empty string is an invalid datetime format")
-					}
-					return ParseDateTime(input)
-				case "duration":
-					dur, err := ParseDuration(data)
-					if err != nil {
-						return nil, err
-					}
-					return Duration(dur), nil
-				case "uri":
-					return URI(data), nil
-				case "email":
-					return Email(data), nil
-				case "uuid":
-					return UUID(data), nil
-				case "uuid3":
-					return UUID3(data), nil
-				case "uuid4":
-					return UUID4(data), nil
-				case "uuid5":
-					return UUID5(data), nil
-				case "hostname":
-					return Hostname(data), nil
-				case "ipv4":
-					return IPv4(data), nil
-				case "ipv6":
-					return IPv6(data), nil
-				case "cidr":
-					return CIDR(data), nil
-				case "mac":
-					return MAC(data), nil
-				case "isbn":
-					return ISBN(data), nil
-				case "isbn10":
-					return ISBN10(data), nil
-				case "isbn13":
-					return ISBN13(data), nil
-				case "creditcard":
-					return CreditCard(data), nil
-				case "ssn":
-					return SSN(data), nil
-				case "hexcolor":
-					return HexColor(data), nil
-				case "rgbcolor":
-					return RGBColor(data), nil
-				case "byte":
-					return Base64(data), nil
-				case "password":
-					return Password(data), nil
-				case "ulid":
-					ulid, err := ParseULID(data)
-					if err != nil {
-						return nil, err
-					}
-					return ulid, nil
-				default:
-					return nil, errors.InvalidTypeName(v.Name)
+				decoded, err := decodeFormat(v.Name, data)
+				if err != nil {
+					return nil, &ValidationError{Format: v.OrigName, Value: data, Reason: err.Error(), err: err}
 				}
+				return decoded, nil
 			}
 		}
 		return data, nil
 	}
+
+	return hook
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	dateType     = reflect.TypeOf(Date{})
+	dateTimeType = reflect.TypeOf(DateTime{})
+)
+
+// decodeTime converts a time.Time or *time.Time to a Date or DateTime (or pointers to these), when registered.
+//
+// A time converted to a Date is truncated to its calendar day, at midnight in DefaultTimeLocation.
+// Other values are returned unchanged.
+func (f *defaultFormats) decodeTime(to reflect.Type, obj interface{}) interface{} {
+	var t time.Time
+	switch v := obj.(type) {
+	case time.Time:
+		t = v
+	case *time.Time:
+		if v == nil {
+			return obj
+		}
+		t = *v
+	default:
+		return obj
+	}
+
+	target := to
+	if target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+	if !f.containsType(target) {
+		return obj
+	}
+
+	var decoded interface{}
+	switch target {
+	case dateType:
+		year, month, day := t.Date()
+		decoded = Date(time.Date(year, month, day, 0, 0, 0, 0, DefaultTimeLocation))
+	case dateTimeType:
+		decoded = DateTime(t)
+	default:
+		return obj
+	}
+
+	if to.Kind() == reflect.Ptr {
+		ptr := reflect.New(target)
+		ptr.Elem().Set(reflect.ValueOf(decoded))
+		return ptr.Interface()
+	}
+	return decoded
 }
 
-// Add adds a new format, return true if this was a new item instead of a replacement
+// decodeFormat parses data as the format registered under the specified normalized name
+func decodeFormat(name, data string) (interface{}, error) {
+	switch name {
+	case "date":
+		d, err := time.ParseInLocation(RFC3339FullDate, data, DefaultTimeLocation)
+		if err != nil {
+			return nil, err
+		}
+		return Date(d), nil
+	case "datetime":
+		input := data
+		if len(input) == 0 {
+			return nil, stderrors.New("empty string is an invalid datetime format")
+		}
+		return ParseDateTime(input)
+	case "duration":
+		dur, err := ParseDuration(data)
+		if err != nil {
+			return nil, err
+		}
+		return Duration(dur), nil
+	case "uri":
+		return URI(data), nil
+	case "urireference":
+		return URIReference(data), nil
+	case "irireference":
+		return IRIReference(data), nil
+	case "mimetype":
+		return MimeType(data), nil
+	case "geocoordinate":
+		return GeoCoordinate(data), nil
+	case "dataurl":
+		return DataURL(data), nil
+	case "regex":
+		return RegexPattern(data), nil
+	case "idnemail":
+		return IDNEmail(data), nil
+	case "idnhostname":
+		return IDNHostname(data), nil
+	case "uritemplate":
+		return URITemplate(data), nil
+	case "email":
+		return Email(data), nil
+	case "emaillist":
+		var l EmailList
+		if err := l.UnmarshalText([]byte(data)); err != nil {
+			return nil, err
+		}
+		return l, nil
+	case "hostnamelist":
+		var l HostnameList
+		if err := l.UnmarshalText([]byte(data)); err != nil {
+			return nil, err
+		}
+		return l, nil
+	case "uuid":
+		return UUID(data), nil
+	case "uuid3":
+		return UUID3(data), nil
+	case "uuid4":
+		return UUID4(data), nil
+	case "uuid5":
+		return UUID5(data), nil
+	case "uuid6":
+		return UUID6(data), nil
+	case "uuid7":
+		return UUID7(data), nil
+	case "hostname":
+		return Hostname(data), nil
+	case "wildcardhostname":
+		return WildcardHostname(data), nil
+	case "timezone":
+		return TimeZone(data), nil
+	case "cron":
+		return CronExpression(data), nil
+	case "bic":
+		return BIC(data), nil
+	case "asn":
+		return ASN(data), nil
+	case "hex":
+		return HexString(data), nil
+	case "ipv4":
+		return IPv4(data), nil
+	case "ipv6":
+		return IPv6(data), nil
+	case "cidr":
+		return CIDR(data), nil
+	case "cidrlist":
+		var l CIDRList
+		if err := l.UnmarshalText([]byte(data)); err != nil {
+			return nil, err
+		}
+		return l, nil
+	case "jsonpointer":
+		return JSONPointer(data), nil
+	case "mac":
+		return MAC(data), nil
+	case "networkaddress":
+		return NetworkAddress(data), nil
+	case "isbn":
+		return ISBN(data), nil
+	case "isbn10":
+		return ISBN10(data), nil
+	case "isbn13":
+		return ISBN13(data), nil
+	case "creditcard":
+		return CreditCard(data), nil
+	case "ssn":
+		return SSN(data), nil
+	case "hexcolor":
+		return HexColor(data), nil
+	case "rgbcolor":
+		return RGBColor(data), nil
+	case "hslcolor":
+		return HSLColor(data), nil
+	case "byte":
+		return Base64(data), nil
+	case "bytestd":
+		var b Base64Std
+		if err := b.UnmarshalText([]byte(data)); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "byteurl":
+		var b Base64URL
+		if err := b.UnmarshalText([]byte(data)); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "password":
+		return Password(data), nil
+	case "epoch":
+		v, err := parseEpoch(data)
+		return Epoch(v), err
+	case "epochmillis":
+		v, err := parseEpoch(data)
+		return EpochMillis(v), err
+	case "epochnanos":
+		v, err := parseEpoch(data)
+		return EpochNanos(v), err
+	case "port":
+		return ParsePortNumber(data)
+	case "ulid":
+		ulid, err := ParseULID(data)
+		if err != nil {
+			return nil, err
+		}
+		return ulid, nil
+	default:
+		return nil, errors.InvalidTypeName(name)
+	}
+}
+
+// containsType returns true if this registry contains a format with the specified type
+func (f *defaultFormats) containsType(tpe reflect.Type) bool {
+	f.Lock()
+	defer f.Unlock()
+	for _, v := range f.data {
+		if v.Type == tpe {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors reported by TryAdd, as unwrapped from a *RegistrationError
+var (
+	ErrFormatAlreadyRegistered = stderrors.New("format already registered")
+	ErrNilFormat               = stderrors.New("nil format")
+)
+
+// RegistrationError is returned by TryAdd when a format cannot be registered
+type RegistrationError struct {
+	// Name is the name of the rejected format
+	Name string
+
+	// err is the reason of the rejection: ErrFormatAlreadyRegistered or ErrNilFormat
+	err error
+}
+
+func (e *RegistrationError) Error() string {
+	return fmt.Sprintf("strfmt: cannot register format %q: %v", e.Name, e.err)
+}
+
+// Unwrap returns the reason of the rejection, to support errors.Is
+// (e.g. errors.Is(err, ErrFormatAlreadyRegistered))
+func (e *RegistrationError) Unwrap() error {
+	return e.err
+}
+
+// Add adds a new format, return true if this was a new item instead of a replacement.
+//
+// Adding a nil format does nothing and returns false. Use TryAdd to find out why a format is rejected.
 func (f *defaultFormats) Add(name string, strfmt Format, validator Validator) bool {
+	if strfmt == nil {
+		return false
+	}
+
 	f.Lock()
 	defer f.Unlock()
 
+	return f.add(name, strfmt, validator)
+}
+
+// TryAdd adds a new format.
+//
+// Unlike Add, formats already registered under the same name (or one of its aliases) are not replaced:
+// a *RegistrationError is returned when the name is already registered or when the format is nil.
+func (f *defaultFormats) TryAdd(name string, strfmt Format, validator Validator) error {
+	if strfmt == nil {
+		return &RegistrationError{Name: name, err: ErrNilFormat}
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	nme := f.normalizeName(name)
+	for _, v := range f.data {
+		if v.Name == nme {
+			return &RegistrationError{Name: name, err: ErrFormatAlreadyRegistered}
+		}
+	}
+
+	f.add(name, strfmt, validator)
+	return nil
+}
+
+// add registers a format, replacing any format registered under the same name. Callers hold the lock.
+func (f *defaultFormats) add(name string, strfmt Format, validator Validator) bool {
 	nme := f.normalizeName(name)
 
 	tpe := reflect.TypeOf(strfmt)
@@ -199,6 +601,11 @@ func (f *defaultFormats) Add(name string, strfmt Format, validator Validator) bo
 		if v.Name == nme {
 			v.Type = tpe
 			v.Validator = validator
+			v.ContextualValidator = nil
+			if name != v.OrigName && !containsString(v.Aliases, name) {
+				// the full slice expression ensures seeded registries do not share the new alias
+				v.Aliases = append(v.Aliases[:len(v.Aliases):len(v.Aliases)], name)
+			}
 			return false
 		}
 	}
@@ -208,6 +615,207 @@ func (f *defaultFormats) Add(name string, strfmt Format, validator Validator) bo
 	return true
 }
 
+// AddContextual adds a new format validated by a ContextualValidator,
+// return true if this was a new item instead of a replacement.
+//
+// ValidateWithContext calls the validator with its context. Validates and ValidateWithError
+// call it with context.Background().
+//
+// Like Add, adding a nil format does nothing and returns false.
+func (f *defaultFormats) AddContextual(name string, strfmt Format, validator ContextualValidator) bool {
+	if strfmt == nil {
+		return false
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	// the format and its contextual validator are registered at once, under the same lock
+	isNew := f.add(name, strfmt, func(data string) bool {
+		return validator(context.Background(), data) == nil
+	})
+	f.data[f.indexOf(name)].ContextualValidator = validator
+	return isNew
+}
+
+// AddWithDescription adds a new format documented by a FormatDescription,
+// return true if this was a new item instead of a replacement.
+//
+// The name of the description is set to the name of the format.
+func (f *defaultFormats) AddWithDescription(name string, strfmt Format, validator Validator, desc FormatDescription) bool {
+	isNew := f.Add(name, strfmt, validator)
+	f.setDescription(name, desc)
+	return isNew
+}
+
+// setDescription sets the description of a registered format
+func (f *defaultFormats) setDescription(name string, desc FormatDescription) {
+	f.Lock()
+	defer f.Unlock()
+	nme := f.normalizeName(name)
+	for i := range f.data {
+		if f.data[i].Name == nme {
+			f.data[i].Description = desc
+			break
+		}
+	}
+}
+
+// Describe returns the documentation of a format.
+//
+// Formats registered without a description are described by their name only.
+// An error is returned when the format is not registered.
+func (f *defaultFormats) Describe(name string) (FormatDescription, error) {
+	f.Lock()
+	defer f.Unlock()
+	nme := f.normalizeName(name)
+	for _, v := range f.data {
+		if v.Name == nme {
+			desc := v.Description
+			desc.Name = v.OrigName
+			return desc, nil
+		}
+	}
+	return FormatDescription{}, errors.InvalidTypeName(name)
+}
+
+// AllDescriptions returns the documentation of all the registered formats, sorted by name
+func (f *defaultFormats) AllDescriptions() []FormatDescription {
+	f.Lock()
+	defer f.Unlock()
+	descs := make([]FormatDescription, 0, len(f.data))
+	for _, v := range f.data {
+		desc := v.Description
+		desc.Name = v.OrigName
+		descs = append(descs, desc)
+	}
+	sort.Slice(descs, func(i, j int) bool { return descs[i].Name < descs[j].Name })
+	return descs
+}
+
+// UnregisterAll removes all the formats from this registry
+func (f *defaultFormats) UnregisterAll() {
+	f.Lock()
+	defer f.Unlock()
+	f.data = nil
+}
+
+// Reset restores the built-in formats of this package, and removes all the other formats from this registry.
+//
+// The built-in formats are registered again as they are when the package is initialized,
+// so that formats which have been overridden by Add recover their original type and validator.
+// Reset does not change the strict mode of the registry.
+//
+// The built-in formats are registered in a new set of formats, which replaces the formats of this registry at once:
+// concurrent callers never see an empty or partially restored registry.
+func (f *defaultFormats) Reset() {
+	builtins := &defaultFormats{normalizeName: f.normalizeName}
+	for _, builtin := range builtinFormats {
+		builtin.register(builtins)
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.data = builtins.data
+}
+
+// RegistrySnapshot captures the formats and the settings of a registry, to be restored later on (e.g. in test fixtures)
+type RegistrySnapshot struct {
+	data      []knownFormat
+	strict    bool
+	onUnknown func(string)
+	encoder   FormatEncoder
+	decoder   FormatDecoder
+}
+
+// Snapshot captures the formats currently registered, as well as the strict mode settings
+// and the codecs (see SetEncoder and SetDecoder) of this registry
+func (f *defaultFormats) Snapshot() *RegistrySnapshot {
+	f.Lock()
+	defer f.Unlock()
+	return &RegistrySnapshot{
+		data:      append([]knownFormat(nil), f.data...),
+		strict:    f.strict,
+		onUnknown: f.onUnknown,
+		encoder:   f.encoder,
+		decoder:   f.decoder,
+	}
+}
+
+// Restore replaces the formats, the strict mode settings and the codecs of this registry with the ones of a snapshot.
+//
+// Restoring a nil snapshot does nothing.
+func (f *defaultFormats) Restore(snap *RegistrySnapshot) {
+	if snap == nil {
+		return
+	}
+	f.Lock()
+	defer f.Unlock()
+	f.data = append([]knownFormat(nil), snap.data...)
+	f.strict = snap.strict
+	f.onUnknown = snap.onUnknown
+	f.encoder = snap.encoder
+	f.decoder = snap.decoder
+}
+
+// SetEncoder sets the codec used by Encode. A nil encoder restores the default JSONFormatCodec.
+func (f *defaultFormats) SetEncoder(enc FormatEncoder) {
+	f.Lock()
+	defer f.Unlock()
+	f.encoder = enc
+}
+
+// SetDecoder sets the codec used by Decode. A nil decoder restores the default JSONFormatCodec.
+func (f *defaultFormats) SetDecoder(dec FormatDecoder) {
+	f.Lock()
+	defer f.Unlock()
+	f.decoder = dec
+}
+
+// Encode serializes a value of the format registered under the specified name with the encoder of this registry.
+//
+// The value is encoded from its text representation: e.g. with the default JSONFormatCodec,
+// a Date is encoded as "2024-01-01", quotes included.
+func (f *defaultFormats) Encode(name string, value Format) ([]byte, error) {
+	canonical, ok := f.CanonicalName(name)
+	if !ok {
+		return nil, errors.InvalidTypeName(name)
+	}
+	text, err := value.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	enc := f.encoder
+	f.Unlock()
+	if enc == nil {
+		enc = JSONFormatCodec{}
+	}
+	return enc.EncodeFormat(canonical, string(text))
+}
+
+// Decode deserializes data with the decoder of this registry, then parses it as the format registered
+// under the specified name (see Parse).
+func (f *defaultFormats) Decode(name string, data []byte) (interface{}, error) {
+	canonical, ok := f.CanonicalName(name)
+	if !ok {
+		return nil, errors.InvalidTypeName(name)
+	}
+
+	f.Lock()
+	dec := f.decoder
+	f.Unlock()
+	if dec == nil {
+		dec = JSONFormatCodec{}
+	}
+	text, err := dec.DecodeFormat(canonical, data)
+	if err != nil {
+		return nil, err
+	}
+	return f.Parse(name, text)
+}
+
 // GetType gets the type for the specified name
 func (f *defaultFormats) GetType(name string) (reflect.Type, bool) {
 	f.Lock()
@@ -271,6 +879,71 @@ func (f *defaultFormats) ContainsName(name string) bool {
 	return false
 }
 
+// HasValidator returns true if this registry has a validator for the specified name, like ContainsName
+func (f *defaultFormats) HasValidator(name string) bool {
+	return f.ContainsName(name)
+}
+
+// CountFormats returns the number of formats in this registry.
+//
+// Aliases of a format are not counted separately.
+func (f *defaultFormats) CountFormats() int {
+	f.Lock()
+	defer f.Unlock()
+	return len(f.data)
+}
+
+// IsEmpty returns true if no format is registered in this registry
+func (f *defaultFormats) IsEmpty() bool {
+	return f.CountFormats() == 0
+}
+
+// CanonicalName returns the name the format resolved by the specified name or alias was first registered with.
+//
+// E.g. with the default name normalizer, CanonicalName("date_time") returns "datetime".
+func (f *defaultFormats) CanonicalName(name string) (string, bool) {
+	f.Lock()
+	defer f.Unlock()
+	nme := f.normalizeName(name)
+	for _, v := range f.data {
+		if v.Name == nme {
+			return v.OrigName, true
+		}
+	}
+	return "", false
+}
+
+// Aliases returns the known names of the format resolved by the specified name or alias:
+// its canonical name, the other names it has been registered with, and its normalized name.
+//
+// Any name with the same normalized form resolves to this format as well. Aliases returns nil for unknown formats.
+func (f *defaultFormats) Aliases(name string) []string {
+	f.Lock()
+	defer f.Unlock()
+	nme := f.normalizeName(name)
+	for _, v := range f.data {
+		if v.Name != nme {
+			continue
+		}
+		aliases := append([]string{v.OrigName}, v.Aliases...)
+		if !containsString(aliases, v.Name) {
+			aliases = append(aliases, v.Name)
+		}
+		return aliases
+	}
+	return nil
+}
+
+// containsString returns true if the string is in the slice
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
 // ContainsFormat returns true if this registry contains the specified format
 func (f *defaultFormats) ContainsFormat(strfmt Format) bool {
 	f.Lock()
@@ -292,18 +965,119 @@ func (f *defaultFormats) ContainsFormat(strfmt Format) bool {
 //
 // Note that the format name is automatically normalized, e.g. one may
 // use "date-time" to use the "datetime" format validator.
+//
+// In strict mode, validating against an unknown format calls the OnUnknownFormat hook, if any.
+// Use ValidateWithError to find out whether a format is unknown.
 func (f *defaultFormats) Validates(name, data string) bool {
 	f.Lock()
-	defer f.Unlock()
 	nme := f.normalizeName(name)
+	var validator Validator
 	for _, v := range f.data {
 		if v.Name == nme {
-			return v.Validator(data)
+			validator = v.Validator
+			break
 		}
 	}
+	strict, onUnknown := f.strict, f.onUnknown
+	f.Unlock()
+
+	if validator != nil {
+		return validator(data)
+	}
+
+	if strict && onUnknown != nil {
+		onUnknown(name)
+	}
 	return false
 }
 
+// ValidateWithError validates the passed data against a format, and explains why it is not valid.
+//
+// It returns nil when the data is valid. Otherwise, the returned ValidationError tells the reason of the failure.
+//
+// Data validated against an unknown format is rejected as well. By default, it is rejected like Validates does,
+// as an invalid value. In strict mode, the OnUnknownFormat hook is called first, if any, and the returned
+// ValidationError tells the format is unknown: its reason is "unknown format", and it wraps an errors.InvalidTypeName error.
+//
+// Note that the returned pointer should be checked against nil before being used as an error,
+// since a nil *ValidationError is a non-nil error.
+func (f *defaultFormats) ValidateWithError(name, data string) *ValidationError {
+	f.Lock()
+	nme := f.normalizeName(name)
+	var known knownFormat
+	for _, v := range f.data {
+		if v.Name == nme {
+			known = v
+			break
+		}
+	}
+	strict, onUnknown := f.strict, f.onUnknown
+	f.Unlock()
+
+	if known.Validator == nil {
+		if !strict {
+			return &ValidationError{Format: name, Value: data, Reason: validationReason(name, data)}
+		}
+		if onUnknown != nil {
+			onUnknown(name)
+		}
+		return &ValidationError{Format: name, Value: data, Reason: "unknown format", err: errors.InvalidTypeName(name)}
+	}
+	if known.Validator(data) {
+		return nil
+	}
+	return &ValidationError{Format: name, Value: data, Reason: validationReason(known.OrigName, data)}
+}
+
+// ValidateWithContext validates the passed data against a format, in the specified context.
+//
+// Formats registered with AddContextual are validated by their ContextualValidator, and the error it returns
+// is reported wrapped in a *ValidationError. Other formats fall back to ValidateWithError.
+func (f *defaultFormats) ValidateWithContext(ctx context.Context, name, data string) error {
+	f.Lock()
+	nme := f.normalizeName(name)
+	var validator ContextualValidator
+	for _, v := range f.data {
+		if v.Name == nme {
+			validator = v.ContextualValidator
+			break
+		}
+	}
+	f.Unlock()
+
+	if validator == nil {
+		if err := f.ValidateWithError(name, data); err != nil {
+			return err
+		}
+		return nil
+	}
+	if err := validator(ctx, data); err != nil {
+		return &ValidationError{Format: name, Value: data, Reason: err.Error(), err: err}
+	}
+	return nil
+}
+
+// SetStrict toggles the strict mode of this registry.
+//
+// In strict mode, validating against an unknown format name calls the OnUnknownFormat hook, if any,
+// and ValidateWithError and ValidateWithContext report the format as unknown rather than the value as invalid.
+// Strict mode is disabled by default.
+func (f *defaultFormats) SetStrict(strict bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.strict = strict
+}
+
+// OnUnknownFormat sets a hook called in strict mode when Validates, ValidateWithError or ValidateWithContext
+// is called with an unknown format name.
+//
+// Validates still returns false after the hook has been called. The hook may panic to make unknown formats fatal.
+func (f *defaultFormats) OnUnknownFormat(fn func(name string)) {
+	f.Lock()
+	defer f.Unlock()
+	f.onUnknown = fn
+}
+
 // Parse a string into the appropriate format representation type.
 //
 // E.g. parsing a string a "date" will return a Date type.
@@ -325,3 +1099,204 @@ func (f *defaultFormats) Parse(name, data string) (interface{}, error) {
 	}
 	return nil, errors.InvalidTypeName(name)
 }
+
+// names returns the names of the registered formats, as they were originally registered
+func (f *defaultFormats) names() []string {
+	f.Lock()
+	defer f.Unlock()
+	names := make([]string, 0, len(f.data))
+	for _, v := range f.data {
+		names = append(names, v.OrigName)
+	}
+	return names
+}
+
+// validator returns the validators registered for the specified name
+func (f *defaultFormats) validator(name string) (Validator, ContextualValidator, bool) {
+	f.Lock()
+	defer f.Unlock()
+	nme := f.normalizeName(name)
+	for _, v := range f.data {
+		if v.Name == nme {
+			return v.Validator, v.ContextualValidator, true
+		}
+	}
+	return nil, nil, false
+}
+
+// formatCopy is a format read from a source registry, to be added to another registry
+type formatCopy struct {
+	name        string
+	format      Format
+	validator   Validator
+	contextual  ContextualValidator
+	description FormatDescription
+}
+
+// readFormats reads the formats registered under the specified names in source
+func readFormats(names []string, source Registry) ([]formatCopy, error) {
+	copies := make([]formatCopy, 0, len(names))
+	for _, name := range names {
+		tpe, ok := source.GetType(name)
+		if !ok {
+			return nil, fmt.Errorf("format %q is not registered in the source registry", name)
+		}
+		format, ok := reflect.New(tpe).Interface().(Format)
+		if !ok {
+			return nil, errors.InvalidTypeName(name)
+		}
+
+		c := formatCopy{name: name, format: format}
+		if src, isDefault := source.(*defaultFormats); isDefault {
+			c.validator, c.contextual, _ = src.validator(name)
+		} else {
+			nme := name
+			c.validator = func(data string) bool { return source.Validates(nme, data) }
+		}
+		if describer, ok := source.(DescribingRegistry); ok {
+			if desc, err := describer.Describe(name); err == nil {
+				c.description = desc
+			}
+		}
+		copies = append(copies, c)
+	}
+	return copies, nil
+}
+
+// copyFrom adds to this registry the formats registered under the specified names in source.
+//
+// All the formats are read from source before this registry is changed, and they are added at once:
+// nothing is copied when an error is returned. Unless override is true, formats already registered
+// in this registry are not replaced, and a *MergeConflictError listing them is returned instead.
+func (f *defaultFormats) copyFrom(names []string, source Registry, override bool) error {
+	copies, err := readFormats(names, source)
+	if err != nil {
+		return err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	if !override {
+		var conflicts []string
+		for _, c := range copies {
+			if f.indexOf(c.name) >= 0 {
+				conflicts = append(conflicts, c.name)
+			}
+		}
+		if len(conflicts) > 0 {
+			return &MergeConflictError{Names: conflicts}
+		}
+	}
+
+	for _, c := range copies {
+		f.add(c.name, c.format, c.validator)
+		known := &f.data[f.indexOf(c.name)]
+		known.ContextualValidator = c.contextual
+		known.Description = c.description
+	}
+	return nil
+}
+
+// indexOf returns the index of the format registered under a name, or -1. Callers hold the lock.
+func (f *defaultFormats) indexOf(name string) int {
+	nme := f.normalizeName(name)
+	for i := range f.data {
+		if f.data[i].Name == nme {
+			return i
+		}
+	}
+	return -1
+}
+
+// registryNames returns the names of the formats registered in a registry.
+//
+// Registries which do not implement RegistrySerializer cannot list their formats.
+func registryNames(r Registry) ([]string, error) {
+	if src, isDefault := r.(*defaultFormats); isDefault {
+		return src.names(), nil
+	}
+	serializer, ok := r.(RegistrySerializer)
+	if !ok {
+		return nil, fmt.Errorf("the formats of a %T registry cannot be listed", r)
+	}
+	var buf bytes.Buffer
+	if err := serializer.SaveToJSON(&buf); err != nil {
+		return nil, err
+	}
+	var names []string
+	if err := json.NewDecoder(&buf).Decode(&names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// MergeConflictError is returned by Merge when formats of the merged registry are already registered
+type MergeConflictError struct {
+	// Names are the conflicting format names, as registered in the merged registry
+	Names []string
+}
+
+func (e *MergeConflictError) Error() string {
+	return fmt.Sprintf("strfmt: cannot merge registries, formats already registered: %s", strings.Join(e.Names, ", "))
+}
+
+// Merge copies all the formats from another registry into this registry.
+//
+// When some of these formats are already registered, nothing is copied and a *MergeConflictError
+// listing the conflicting names is returned. Use MergeOverride to replace conflicting formats instead.
+//
+// The merged validators are called under the same conditions as the ones added with Add:
+// they are only as safe for concurrent use as this registry itself.
+func (f *defaultFormats) Merge(other Registry) error {
+	names, err := registryNames(other)
+	if err != nil {
+		return err
+	}
+	return f.copyFrom(names, other, false)
+}
+
+// MergeOverride copies all the formats from another registry into this registry,
+// replacing the formats already registered under the same names.
+//
+// When the formats of the other registry cannot be read, an error is returned and nothing is copied.
+func (f *defaultFormats) MergeOverride(other Registry) error {
+	names, err := registryNames(other)
+	if err != nil {
+		return err
+	}
+	return f.copyFrom(names, other, true)
+}
+
+// SaveToJSON writes the names of the registered formats as a JSON array.
+//
+// Validators cannot be serialized: use LoadFromJSON to reconstruct a registry from a source registry.
+func (f *defaultFormats) SaveToJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(f.names())
+}
+
+// LoadFromJSON reads a JSON array of format names, as written by SaveToJSON,
+// and copies the matching formats from the source registry into this registry.
+//
+// An error is returned, and nothing is copied, if any of the names is not registered in the source registry.
+func (f *defaultFormats) LoadFromJSON(r io.Reader, source Registry) error {
+	var names []string
+	if err := json.NewDecoder(r).Decode(&names); err != nil {
+		return err
+	}
+	return f.copyFrom(names, source, true)
+}
+
+// MarshalJSON returns the names of the registered formats as a JSON array
+func (f *defaultFormats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.names())
+}
+
+// UnmarshalJSON reads a JSON array of format names and copies the matching formats from the Default registry
+func (f *defaultFormats) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	return f.copyFrom(names, Default, true)
+}
@@ -15,10 +15,18 @@
 package strfmt
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-openapi/errors"
 	"github.com/mitchellh/mapstructure"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,6 +47,14 @@ func (t testFormat) String() string {
 	return string(t)
 }
 
+// asFormatRegistry returns a registry of this package with all its optional features
+func asFormatRegistry(t testing.TB, registry Registry) FormatRegistry {
+	t.Helper()
+	extended, ok := registry.(FormatRegistry)
+	require.True(t, ok)
+	return extended
+}
+
 func isTestFormat(s string) bool {
 	return strings.HasPrefix(s, "tf")
 }
@@ -93,7 +109,7 @@ func init() {
 func TestFormatRegistry(t *testing.T) {
 	f2 := tf2("")
 	f3 := bf("")
-	registry := NewFormats()
+	registry := asFormatRegistry(t, NewFormats())
 
 	assert.True(t, registry.ContainsName("test-format"))
 	assert.True(t, registry.ContainsName("testformat"))
@@ -128,35 +144,566 @@ func TestFormatRegistry(t *testing.T) {
 	assert.False(t, registry.Validates("unknown", ""))
 }
 
+func TestFormatRegistry_TryAdd(t *testing.T) {
+	f2 := tf2("")
+	f3 := bf("")
+	registry := asFormatRegistry(t, NewFormats())
+
+	require.NoError(t, registry.TryAdd("tf2", &f2, istf2))
+	assert.True(t, registry.Validates("tf2", "afa"))
+
+	err := registry.TryAdd("tf2", &f3, isbf)
+	require.Error(t, err)
+	var regErr *RegistrationError
+	require.ErrorAs(t, err, &regErr)
+	assert.Equal(t, "tf2", regErr.Name)
+	require.ErrorIs(t, err, ErrFormatAlreadyRegistered)
+	assert.True(t, registry.Validates("tf2", "afa"), "a rejected format should not replace the registered one")
+
+	// names are normalized before looking for collisions
+	require.ErrorIs(t, registry.TryAdd("TF_2", &f3, isbf), ErrFormatAlreadyRegistered)
+	require.ErrorIs(t, registry.TryAdd("test_format", &f3, isbf), ErrFormatAlreadyRegistered)
+
+	err = registry.TryAdd("tf3", nil, istf3)
+	require.ErrorIs(t, err, ErrNilFormat)
+	require.ErrorAs(t, err, &regErr)
+	assert.Equal(t, "tf3", regErr.Name)
+	assert.False(t, registry.ContainsName("tf3"))
+	assert.False(t, registry.Add("tf3", nil, istf3))
+	assert.False(t, registry.ContainsName("tf3"))
+
+	require.True(t, registry.DelByName("tf2"))
+	require.NoError(t, registry.TryAdd("tf2", &f3, isbf))
+	assert.True(t, registry.Validates("tf2", "bfa"))
+}
+
+func TestFormatRegistry_CountFormats(t *testing.T) {
+	registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+	assert.True(t, registry.IsEmpty())
+	assert.Zero(t, registry.CountFormats())
+	assert.False(t, registry.HasValidator("date-time"))
+
+	dt := DateTime{}
+	registry.Add("date-time", &dt, IsDateTime)
+	registry.Add("DateTime", &dt, IsDateTime)
+	d := Date{}
+	registry.Add("date", &d, IsDate)
+
+	assert.False(t, registry.IsEmpty())
+	assert.Equal(t, 2, registry.CountFormats())
+	assert.True(t, registry.HasValidator("date_time"))
+	assert.True(t, registry.HasValidator("date"))
+	assert.False(t, registry.HasValidator("uuid"))
+
+	registry.UnregisterAll()
+	assert.True(t, registry.IsEmpty())
+
+	registry.Reset()
+	assert.Equal(t, len(builtinFormats), registry.CountFormats())
+}
+
+func TestFormatRegistry_Aliases(t *testing.T) {
+	registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+	dt := DateTime{}
+	assert.True(t, registry.Add("date-time", &dt, IsDateTime))
+
+	for _, alias := range []string{"date-time", "datetime", "DateTime", "date_time", "DATE-TIME"} {
+		assert.Truef(t, registry.ContainsName(alias), "expected %q to resolve to date-time", alias)
+
+		canonical, ok := registry.CanonicalName(alias)
+		assert.Truef(t, ok, "expected %q to have a canonical name", alias)
+		assert.Equal(t, "date-time", canonical)
+	}
+	assert.True(t, registry.Validates("DateTime", "2012-03-02T15:06:05.999Z"))
+	assert.Equal(t, []string{"date-time", "datetime"}, registry.Aliases("DateTime"))
+
+	_, ok := registry.CanonicalName("date")
+	assert.False(t, ok)
+	assert.Nil(t, registry.Aliases("date"))
+
+	t.Run("registering an alias records it", func(t *testing.T) {
+		assert.False(t, registry.Add("Date_Time", &dt, IsDateTime))
+		assert.False(t, registry.Add("Date_Time", &dt, IsDateTime))
+
+		canonical, _ := registry.CanonicalName("datetime")
+		assert.Equal(t, "date-time", canonical)
+		assert.Equal(t, []string{"date-time", "Date_Time", "datetime"}, registry.Aliases("date-time"))
+	})
+
+	t.Run("aliases are not shared with seeded registries", func(t *testing.T) {
+		seeded := asFormatRegistry(t, NewSeededFormats(registry.(*defaultFormats).data, nil))
+		assert.False(t, seeded.Add("DATETIME", &dt, IsDateTime))
+		assert.Equal(t, []string{"date-time", "Date_Time", "datetime"}, registry.Aliases("date-time"))
+		assert.Equal(t, []string{"date-time", "Date_Time", "DATETIME", "datetime"}, seeded.Aliases("date-time"))
+	})
+
+	t.Run("default registry", func(t *testing.T) {
+		assert.True(t, Default.ContainsName("date-time"))
+		assert.True(t, Default.ContainsName("datetime"))
+		assert.True(t, Default.ContainsName("DateTime"))
+		canonical, ok := asFormatRegistry(t, Default).CanonicalName("idn_email")
+		assert.True(t, ok)
+		assert.Equal(t, "idn-email", canonical)
+	})
+}
+
 type testStruct struct {
-	D          Date       `json:"d,omitempty"`
-	DT         DateTime   `json:"dt,omitempty"`
-	Dur        Duration   `json:"dur,omitempty"`
-	URI        URI        `json:"uri,omitempty"`
-	Eml        Email      `json:"eml,omitempty"`
-	UUID       UUID       `json:"uuid,omitempty"`
-	UUID3      UUID3      `json:"uuid3,omitempty"`
-	UUID4      UUID4      `json:"uuid4,omitempty"`
-	UUID5      UUID5      `json:"uuid5,omitempty"`
-	Hn         Hostname   `json:"hn,omitempty"`
-	Ipv4       IPv4       `json:"ipv4,omitempty"`
-	Ipv6       IPv6       `json:"ipv6,omitempty"`
-	Cidr       CIDR       `json:"cidr,omitempty"`
-	Mac        MAC        `json:"mac,omitempty"`
-	Isbn       ISBN       `json:"isbn,omitempty"`
-	Isbn10     ISBN10     `json:"isbn10,omitempty"`
-	Isbn13     ISBN13     `json:"isbn13,omitempty"`
-	Creditcard CreditCard `json:"creditcard,omitempty"`
-	Ssn        SSN        `json:"ssn,omitempty"`
-	Hexcolor   HexColor   `json:"hexcolor,omitempty"`
-	Rgbcolor   RGBColor   `json:"rgbcolor,omitempty"`
-	B64        Base64     `json:"b64,omitempty"`
-	Pw         Password   `json:"pw,omitempty"`
-	ULID       ULID       `json:"ulid,omitempty"`
+	D          Date             `json:"d,omitempty"`
+	DT         DateTime         `json:"dt,omitempty"`
+	Dur        Duration         `json:"dur,omitempty"`
+	URI        URI              `json:"uri,omitempty"`
+	Eml        Email            `json:"eml,omitempty"`
+	UUID       UUID             `json:"uuid,omitempty"`
+	UUID3      UUID3            `json:"uuid3,omitempty"`
+	UUID4      UUID4            `json:"uuid4,omitempty"`
+	UUID5      UUID5            `json:"uuid5,omitempty"`
+	Hn         Hostname         `json:"hn,omitempty"`
+	Ipv4       IPv4             `json:"ipv4,omitempty"`
+	Ipv6       IPv6             `json:"ipv6,omitempty"`
+	Cidr       CIDR             `json:"cidr,omitempty"`
+	Mac        MAC              `json:"mac,omitempty"`
+	Isbn       ISBN             `json:"isbn,omitempty"`
+	Isbn10     ISBN10           `json:"isbn10,omitempty"`
+	Isbn13     ISBN13           `json:"isbn13,omitempty"`
+	Creditcard CreditCard       `json:"creditcard,omitempty"`
+	Ssn        SSN              `json:"ssn,omitempty"`
+	Hexcolor   HexColor         `json:"hexcolor,omitempty"`
+	Rgbcolor   RGBColor         `json:"rgbcolor,omitempty"`
+	Hslcolor   HSLColor         `json:"hslcolor,omitempty"`
+	B64        Base64           `json:"b64,omitempty"`
+	B64Std     Base64Std        `json:"b64std,omitempty"`
+	B64URL     Base64URL        `json:"b64url,omitempty"`
+	Pw         Password         `json:"pw,omitempty"`
+	ULID       ULID             `json:"ulid,omitempty"`
+	Port       PortNumber       `json:"port,omitempty"`
+	Epoch      Epoch            `json:"epoch,omitempty"`
+	EpochMs    EpochMillis      `json:"epochms,omitempty"`
+	EpochNs    EpochNanos       `json:"epochns,omitempty"`
+	NetAddr    NetworkAddress   `json:"netaddr,omitempty"`
+	JSONPtr    JSONPointer      `json:"jsonptr,omitempty"`
+	URITpl     URITemplate      `json:"uritpl,omitempty"`
+	IDNHost    IDNHostname      `json:"idnhost,omitempty"`
+	IDNMail    IDNEmail         `json:"idnmail,omitempty"`
+	Regex      RegexPattern     `json:"regex,omitempty"`
+	DataURL    DataURL          `json:"dataurl,omitempty"`
+	Geo        GeoCoordinate    `json:"geo,omitempty"`
+	Mime       MimeType         `json:"mime,omitempty"`
+	WildHost   WildcardHostname `json:"wildhost,omitempty"`
+	TZ         TimeZone         `json:"tz,omitempty"`
+	Cron       CronExpression   `json:"cron,omitempty"`
+	BIC        BIC              `json:"bic,omitempty"`
+	ASN        ASN              `json:"asn,omitempty"`
+	Hex        HexString        `json:"hex,omitempty"`
+	Emails     EmailList        `json:"emails,omitempty"`
+	Hosts      HostnameList     `json:"hosts,omitempty"`
+	Networks   CIDRList         `json:"networks,omitempty"`
+	UUID7      UUID7            `json:"uuid7,omitempty"`
+	UUID6      UUID6            `json:"uuid6,omitempty"`
+}
+
+func TestFormatRegistry_Strict(t *testing.T) {
+	registry := asFormatRegistry(t, NewFormats())
+
+	t.Run("unknown formats are rejected silently by default", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			assert.False(t, registry.Validates("unknown-format", "anything"))
+		})
+
+		verr := registry.ValidateWithError("unknown-format", "anything")
+		require.NotNil(t, verr)
+		assert.Equal(t, "must be a valid unknown-format", verr.Reason)
+		var apiErr errors.Error
+		assert.False(t, stderrors.As(verr, &apiErr), "the format should not be reported as unknown")
+	})
+
+	t.Run("unknown formats are rejected without a hook in strict mode", func(t *testing.T) {
+		registry.SetStrict(true)
+		defer registry.SetStrict(false)
+
+		assert.NotPanics(t, func() {
+			assert.False(t, registry.Validates("unknown-format", "anything"))
+			assert.True(t, registry.Validates("date-time", "2012-03-02T15:06:05.999Z"))
+			assert.False(t, registry.Validates("date-time", "anything"))
+		})
+
+		verr := registry.ValidateWithError("unknown-format", "anything")
+		require.NotNil(t, verr)
+		var apiErr errors.Error
+		require.ErrorAs(t, verr, &apiErr)
+		assert.Equal(t, "unknown format", verr.Reason)
+		assert.Equal(t, `"anything" is not a valid unknown-format: unknown format`, verr.Error())
+
+		err := registry.ValidateWithContext(context.Background(), "unknown-format", "anything")
+		require.ErrorAs(t, err, &apiErr)
+		assert.EqualError(t, err, `"anything" is not a valid unknown-format: unknown format`)
+	})
+
+	t.Run("unknown formats panic when the hook panics", func(t *testing.T) {
+		registry.SetStrict(true)
+		defer registry.SetStrict(false)
+		registry.OnUnknownFormat(func(name string) { panic("unknown format " + name) })
+		defer registry.OnUnknownFormat(nil)
+
+		assert.PanicsWithValue(t, "unknown format unknown-format", func() {
+			_ = registry.Validates("unknown-format", "anything")
+		})
+		assert.PanicsWithValue(t, "unknown format unknown-format", func() {
+			_ = registry.ValidateWithError("unknown-format", "anything")
+		})
+	})
+
+	t.Run("unknown formats call the hook in strict mode", func(t *testing.T) {
+		var unknown []string
+		registry.OnUnknownFormat(func(name string) {
+			unknown = append(unknown, name)
+			// the hook may use the registry
+			_ = registry.ContainsName(name)
+		})
+		defer registry.OnUnknownFormat(nil)
+
+		assert.False(t, registry.Validates("unknown-format", "anything"))
+		assert.Empty(t, unknown, "the hook should not be called when strict mode is disabled")
+
+		registry.SetStrict(true)
+		defer registry.SetStrict(false)
+		assert.False(t, registry.Validates("unknown-format", "anything"))
+		assert.True(t, registry.Validates("uuid", "a8098c1a-f86e-11da-bd1a-00112444be1e"))
+		assert.Equal(t, []string{"unknown-format"}, unknown)
+
+		require.NotNil(t, registry.ValidateWithError("other-format", "anything"))
+		require.Error(t, registry.ValidateWithContext(context.Background(), "third-format", "anything"))
+		assert.Equal(t, []string{"unknown-format", "other-format", "third-format"}, unknown)
+	})
+}
+
+func TestFormatRegistry_JSON(t *testing.T) {
+	source := asFormatRegistry(t, NewSeededFormats(nil, nil))
+	tf := testFormat("")
+	f2 := tf2("")
+	dt := DateTime{}
+	require.True(t, source.Add("test-format", &tf, isTestFormat))
+	require.True(t, source.Add("tf2", &f2, istf2))
+	require.True(t, source.Add("date-time", &dt, IsDateTime))
+
+	var buf bytes.Buffer
+	require.NoError(t, source.SaveToJSON(&buf))
+	assert.JSONEq(t, `["test-format","tf2","date-time"]`, buf.String())
+
+	t.Run("should load a subset of formats from a source registry", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.NoError(t, registry.LoadFromJSON(strings.NewReader(`["tf2","datetime"]`), source))
+
+		assert.False(t, registry.ContainsName("test-format"))
+		assert.True(t, registry.ContainsName("tf2"))
+		assert.True(t, registry.Validates("tf2", "afa"))
+		assert.False(t, registry.Validates("tf2", "tfa"))
+		assert.True(t, registry.Validates("date-time", "2012-03-02T15:06:05.999Z"))
+		tpe, ok := registry.GetType("datetime")
+		require.True(t, ok)
+		assert.Equal(t, reflect.TypeOf(dt), tpe)
+	})
+
+	t.Run("should round trip all formats", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.NoError(t, registry.LoadFromJSON(&buf, source))
+		for _, name := range []string{"test-format", "tf2", "date-time"} {
+			assert.True(t, registry.ContainsName(name))
+		}
+		assert.True(t, registry.Validates("testformat", "tfa"))
+	})
+
+	t.Run("should load from a custom registry", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.NoError(t, registry.LoadFromJSON(strings.NewReader(`["tf2"]`), customRegistry{source}))
+		assert.True(t, registry.Validates("tf2", "afa"))
+		assert.False(t, registry.Validates("tf2", "tfa"))
+	})
+
+	t.Run("should fail on unknown formats", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.Error(t, registry.LoadFromJSON(strings.NewReader(`["tf2","unknown"]`), source))
+		assert.False(t, registry.ContainsName("tf2"), "nothing should be loaded when a format is unknown")
+		require.Error(t, registry.LoadFromJSON(strings.NewReader(`{"tf2":true}`), source))
+	})
+
+	t.Run("should marshal and unmarshal with the Default registry", func(t *testing.T) {
+		b, err := json.Marshal(source)
+		require.NoError(t, err)
+		assert.JSONEq(t, `["test-format","tf2","date-time"]`, string(b))
+
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.NoError(t, json.Unmarshal([]byte(`["date-time","uuid","test-format"]`), registry))
+		assert.True(t, registry.Validates("uuid", "a8098c1a-f86e-11da-bd1a-00112444be1e"))
+		assert.True(t, registry.Validates("testformat", "tfa"))
+		assert.False(t, registry.ContainsName("email"))
+
+		require.Error(t, json.Unmarshal([]byte(`["tf2"]`), registry))
+	})
+}
+
+func TestRegistryMerge(t *testing.T) {
+	tf := testFormat("")
+	f2 := tf2("")
+	dt := DateTime{}
+
+	plugin1 := asFormatRegistry(t, NewSeededFormats(nil, nil))
+	require.True(t, plugin1.Add("test-format", &tf, isTestFormat))
+	plugin2 := asFormatRegistry(t, NewSeededFormats(nil, nil))
+	require.True(t, plugin2.Add("tf2", &f2, istf2))
+	require.True(t, plugin2.Add("date-time", &dt, IsDateTime))
+
+	t.Run("should merge sub-registries", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.NoError(t, registry.Merge(plugin1))
+		require.NoError(t, registry.Merge(customRegistry{plugin2}))
+
+		assert.True(t, registry.Validates("test-format", "tfa"))
+		assert.False(t, registry.Validates("test-format", "afa"))
+		assert.True(t, registry.Validates("tf2", "afa"))
+		assert.False(t, registry.Validates("tf2", "tfa"))
+		assert.True(t, registry.Validates("datetime", "2012-03-02T15:06:05.999Z"))
+		assert.False(t, registry.Validates("datetime", "2012-03-02"))
+	})
+
+	t.Run("should report conflicts", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.True(t, registry.Add("date_time", &dt, func(string) bool { return false }))
+
+		err := registry.Merge(plugin2)
+		require.Error(t, err)
+		var conflict *MergeConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, []string{"date-time"}, conflict.Names)
+		assert.False(t, registry.ContainsName("tf2"))
+		assert.False(t, registry.Validates("datetime", "2012-03-02T15:06:05.999Z"))
+
+		require.NoError(t, registry.MergeOverride(plugin2))
+		assert.True(t, registry.Validates("tf2", "afa"))
+		assert.True(t, registry.Validates("datetime", "2012-03-02T15:06:05.999Z"))
+	})
+
+	t.Run("should not merge anything on failure", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.True(t, registry.Add("date_time", &dt, func(string) bool { return false }))
+
+		// the broken registry lists a format it cannot provide, after formats it can provide
+		broken := namesRegistry{Registry: plugin2, names: []string{"tf2", "date-time", "missing"}}
+		require.Error(t, registry.MergeOverride(broken))
+		require.Error(t, registry.Merge(broken))
+		assert.False(t, registry.ContainsName("tf2"))
+		assert.False(t, registry.Validates("datetime", "2012-03-02T15:06:05.999Z"))
+
+		require.Error(t, registry.MergeOverride(namesRegistry{Registry: plugin2}))
+		assert.Equal(t, 1, registry.CountFormats())
+
+		require.Error(t, registry.MergeOverride(minimalRegistry{Registry: plugin2}))
+		assert.Equal(t, 1, registry.CountFormats())
+	})
+}
+
+// namesRegistry is a registry which lists arbitrary format names, or fails to list them when names is nil
+type namesRegistry struct {
+	Registry
+	names []string
+}
+
+func (r namesRegistry) SaveToJSON(w io.Writer) error {
+	if r.names == nil {
+		return errors.New(0, "cannot list formats")
+	}
+	return json.NewEncoder(w).Encode(r.names)
+}
+
+func (r namesRegistry) LoadFromJSON(io.Reader, Registry) error {
+	return errors.New(0, "cannot load formats")
+}
+
+// minimalRegistry is a registry which implements none of the optional registry interfaces
+type minimalRegistry struct {
+	Registry
+}
+
+type testPrefixKey struct{}
+
+// hasContextPrefix validates values starting with the prefix carried by the context, defaulting to "tf"
+func hasContextPrefix(ctx context.Context, s string) error {
+	prefix, ok := ctx.Value(testPrefixKey{}).(string)
+	if !ok {
+		prefix = "tf"
+	}
+	if !strings.HasPrefix(s, prefix) {
+		return fmt.Errorf("missing prefix %q", prefix)
+	}
+	return nil
+}
+
+func TestFormatRegistry_ValidateWithContext(t *testing.T) {
+	tf := testFormat("")
+	f2 := tf2("")
+	registry := asFormatRegistry(t, NewSeededFormats(nil, nil))
+	require.True(t, registry.AddContextual("test-format", &tf, hasContextPrefix))
+	require.True(t, registry.Add("tf2", &f2, istf2))
+
+	ctx := context.WithValue(context.Background(), testPrefixKey{}, "ctx")
+
+	t.Run("should validate in context", func(t *testing.T) {
+		require.NoError(t, registry.ValidateWithContext(ctx, "test-format", "ctxvalue"))
+
+		err := registry.ValidateWithContext(ctx, "test-format", "tfvalue")
+		require.Error(t, err)
+		var verr *ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Equal(t, `missing prefix "ctx"`, verr.Reason)
+		assert.Equal(t, "tfvalue", verr.Value)
+	})
+
+	t.Run("should validate without context", func(t *testing.T) {
+		assert.True(t, registry.Validates("testformat", "tfvalue"))
+		assert.False(t, registry.Validates("testformat", "ctxvalue"))
+		assert.Nil(t, registry.ValidateWithError("testformat", "tfvalue"))
+	})
+
+	t.Run("should fall back to context-free validators", func(t *testing.T) {
+		require.NoError(t, registry.ValidateWithContext(ctx, "tf2", "afvalue"))
+		require.Error(t, registry.ValidateWithContext(ctx, "tf2", "ctxvalue"))
+		require.Error(t, registry.ValidateWithContext(ctx, "unknown", "ctxvalue"))
+	})
+
+	t.Run("should keep contextual validators when merged", func(t *testing.T) {
+		merged := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.NoError(t, merged.Merge(registry))
+		require.NoError(t, merged.ValidateWithContext(ctx, "test-format", "ctxvalue"))
+	})
+
+	t.Run("should replace contextual validators", func(t *testing.T) {
+		replaced := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		require.NoError(t, replaced.Merge(registry))
+		assert.False(t, replaced.Add("test-format", &tf, isTestFormat))
+		require.NoError(t, replaced.ValidateWithContext(ctx, "test-format", "tfvalue"))
+	})
+
+	t.Run("should not add a nil format", func(t *testing.T) {
+		assert.False(t, registry.AddContextual("tf2", nil, hasContextPrefix))
+		require.NoError(t, registry.ValidateWithContext(ctx, "tf2", "afvalue"), "the registered validator should be kept")
+	})
+
+	t.Run("should register the contextual validator with the format", func(t *testing.T) {
+		concurrent := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		concurrent.SetStrict(true)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 1000; i++ {
+				concurrent.AddContextual("test-format", &tf, hasContextPrefix)
+				concurrent.DelByName("test-format")
+			}
+		}()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				// the format is either unknown, or validated in context
+				if err := concurrent.ValidateWithContext(ctx, "test-format", "ctxvalue"); err != nil {
+					var verr *ValidationError
+					require.ErrorAs(t, err, &verr)
+					require.Equal(t, "unknown format", verr.Reason)
+				}
+			}
+		}
+	})
+}
+
+func TestFormatRegistry_Reset(t *testing.T) {
+	tf := testFormat("")
+	registry := asFormatRegistry(t, NewFormats())
+	require.True(t, registry.Add("reset-format", &tf, isTestFormat))
+	require.False(t, registry.Add("date", &tf, isTestFormat))
+
+	t.Run("should restore a snapshot", func(t *testing.T) {
+		snap := registry.Snapshot()
+		registry.UnregisterAll()
+		assert.False(t, registry.ContainsName("date"))
+		assert.False(t, registry.ContainsName("reset-format"))
+
+		registry.SetStrict(true)
+		registry.Restore(snap)
+		assert.True(t, registry.ContainsName("reset-format"))
+		assert.True(t, registry.Validates("date", "tfa"))
+		assert.NotPanics(t, func() { registry.Validates("unknown", "tfa") })
+
+		registry.Restore(nil)
+		assert.True(t, registry.ContainsName("reset-format"))
+	})
+
+	t.Run("should restore the codecs", func(t *testing.T) {
+		snap := registry.Snapshot()
+		registry.SetEncoder(MsgpackFormatCodec{})
+		registry.SetDecoder(MsgpackFormatCodec{})
+		registry.Restore(snap)
+
+		dt := DateTime(time.Date(2012, time.March, 2, 15, 6, 5, 0, time.UTC))
+		b, err := registry.Encode("date-time", &dt)
+		require.NoError(t, err)
+		assert.Equal(t, `"2012-03-02T15:06:05.000Z"`, string(b))
+		decoded, err := registry.Decode("date-time", b)
+		require.NoError(t, err)
+		assert.Equal(t, &dt, decoded)
+	})
+
+	t.Run("should restore the built-in formats", func(t *testing.T) {
+		registry.Reset()
+		assert.False(t, registry.ContainsName("reset-format"))
+		assert.True(t, registry.Validates("date", "2012-01-01"))
+		assert.False(t, registry.Validates("date", "tfa"))
+		tpe, ok := registry.GetType("date")
+		require.True(t, ok)
+		assert.Equal(t, reflect.TypeOf(Date{}), tpe)
+
+		//nolint:forcetypeassert
+		assert.Len(t, registry.(*defaultFormats).names(), len(builtinFormats))
+		for _, builtin := range builtinFormats {
+			assert.Truef(t, registry.ContainsName(builtin.name), "expected %q to be registered", builtin.name)
+		}
+	})
+
+	t.Run("should reset an empty registry", func(t *testing.T) {
+		empty := asFormatRegistry(t, NewSeededFormats(nil, nil))
+		empty.Reset()
+		assert.True(t, empty.Validates("uuid", "a8098c1a-f86e-11da-bd1a-00112444be1e"))
+	})
+
+	t.Run("should never expose a partially restored registry", func(t *testing.T) {
+		registry := asFormatRegistry(t, NewFormats())
+		registry.Reset()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 1000; i++ {
+				registry.Reset()
+			}
+		}()
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				require.Equal(t, len(builtinFormats), registry.CountFormats())
+				require.True(t, registry.Validates("uuid", "a8098c1a-f86e-11da-bd1a-00112444be1e"))
+			}
+		}
+	})
+}
+
+// customRegistry wraps a registry to exercise registries other than the default implementation
+type customRegistry struct {
+	FormatRegistry
 }
 
 func TestDecodeHook(t *testing.T) {
-	registry := NewFormats()
+	registry := asFormatRegistry(t, NewFormats())
 	m := map[string]interface{}{
 		"d":          "2014-12-15",
 		"dt":         "2012-03-02T15:06:05.999999999Z",
@@ -177,11 +724,38 @@ func TestDecodeHook(t *testing.T) {
 		"isbn13":     "978-0321751041",
 		"hexcolor":   "#FFFFFF",
 		"rgbcolor":   "rgb(255,255,255)",
+		"hslcolor":   "hsl(0,0%,100%)",
 		"pw":         "super secret stuff here",
 		"ssn":        "111-11-1111",
 		"creditcard": "4111-1111-1111-1111",
 		"b64":        "ZWxpemFiZXRocG9zZXk=",
+		"b64std":     "Pz8/Pz8+",
+		"b64url":     "Pz8_Pz8-",
 		"ulid":       "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		"port":       "8080",
+		"epoch":      "1700000000",
+		"epochms":    "1700000000123",
+		"epochns":    "1700000000123456789",
+		"netaddr":    "localhost:8080",
+		"jsonptr":    "/foo/bar~1baz",
+		"uritpl":     "/users/{id}",
+		"idnhost":    "bücher.example.com",
+		"idnmail":    "josé@bücher.example.com",
+		"regex":      "^[a-z]+$",
+		"dataurl":    "data:,Hello",
+		"geo":        "48.8566,2.3522",
+		"mime":       "application/json",
+		"wildhost":   "*.example.com",
+		"emails":     "john@example.com, jane@example.com",
+		"hosts":      "example.com, www.example.com",
+		"networks":   "10.0.0.0/8\n192.168.0.0/16",
+		"uuid7":      "01920ba4-8d4a-7c2e-9f3b-5a1e2d3c4b5a",
+		"uuid6":      "1ef75a3c-8d4a-6c2e-9f3b-5a1e2d3c4b5a",
+		"tz":         "Europe/Paris",
+		"cron":       "*/15 9-17 * * MON-FRI",
+		"bic":        "DEUTDEFF500",
+		"asn":        "AS64496",
+		"hex":        "cafe",
 	}
 
 	date, _ := time.Parse(RFC3339FullDate, "2014-12-15")
@@ -211,9 +785,36 @@ func TestDecodeHook(t *testing.T) {
 		Ssn:        SSN("111-11-1111"),
 		Hexcolor:   HexColor("#FFFFFF"),
 		Rgbcolor:   RGBColor("rgb(255,255,255)"),
+		Hslcolor:   HSLColor("hsl(0,0%,100%)"),
 		B64:        Base64("ZWxpemFiZXRocG9zZXk="),
+		B64Std:     Base64Std("?????>"),
+		B64URL:     Base64URL("?????>"),
 		Pw:         Password("super secret stuff here"),
 		ULID:       ulid,
+		Port:       PortNumber(8080),
+		Epoch:      Epoch(1700000000),
+		EpochMs:    EpochMillis(1700000000123),
+		EpochNs:    EpochNanos(1700000000123456789),
+		NetAddr:    NetworkAddress("localhost:8080"),
+		JSONPtr:    JSONPointer("/foo/bar~1baz"),
+		URITpl:     URITemplate("/users/{id}"),
+		IDNHost:    IDNHostname("bücher.example.com"),
+		IDNMail:    IDNEmail("josé@bücher.example.com"),
+		Regex:      RegexPattern("^[a-z]+$"),
+		DataURL:    DataURL("data:,Hello"),
+		Geo:        GeoCoordinate("48.8566,2.3522"),
+		Mime:       MimeType("application/json"),
+		WildHost:   WildcardHostname("*.example.com"),
+		Emails:     EmailList{"john@example.com", "jane@example.com"},
+		Hosts:      HostnameList{"example.com", "www.example.com"},
+		Networks:   CIDRList{"10.0.0.0/8", "192.168.0.0/16"},
+		UUID7:      UUID7("01920ba4-8d4a-7c2e-9f3b-5a1e2d3c4b5a"),
+		UUID6:      UUID6("1ef75a3c-8d4a-6c2e-9f3b-5a1e2d3c4b5a"),
+		TZ:         TimeZone("Europe/Paris"),
+		Cron:       CronExpression("*/15 9-17 * * MON-FRI"),
+		BIC:        BIC("DEUTDEFF500"),
+		ASN:        ASN("AS64496"),
+		Hex:        HexString("cafe"),
 	}
 
 	test := new(testStruct)
@@ -230,6 +831,167 @@ func TestDecodeHook(t *testing.T) {
 	assert.Equal(t, exp, test)
 }
 
+func TestDecodeHook_Pointers(t *testing.T) {
+	type pointerStruct struct {
+		D      *Date
+		DT     *DateTime
+		Dur    *Duration
+		UUID   *UUID
+		Eml    *Email
+		Hn     *Hostname
+		Ipv4   *IPv4
+		Mac    *MAC
+		B64Std *Base64Std
+		ULID   *ULID
+		Port   *PortNumber
+		Epoch  *Epoch
+		Str    *string
+		Unset  *DateTime
+	}
+
+	registry := asFormatRegistry(t, NewFormats())
+	m := map[string]interface{}{
+		"d":      "2014-12-15",
+		"dt":     "2012-03-02T15:06:05.999999999Z",
+		"dur":    "5s",
+		"uuid":   "a8098c1a-f86e-11da-bd1a-00112444be1e",
+		"eml":    "dummy@dummy.com",
+		"hn":     "somewhere.com",
+		"ipv4":   "192.168.254.1",
+		"mac":    "01:02:03:04:05:06",
+		"b64std": "Pz8/Pz8+",
+		"ulid":   "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		"port":   "8080",
+		"epoch":  "1700000000",
+		"str":    "plain string",
+	}
+
+	date, _ := time.Parse(RFC3339FullDate, "2014-12-15")
+	dur, _ := ParseDuration("5s")
+	dt, _ := ParseDateTime("2012-03-02T15:06:05.999999999Z")
+	ulid, _ := ParseULID("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	str := "plain string"
+
+	exp := &pointerStruct{
+		D:      func() *Date { v := Date(date); return &v }(),
+		DT:     &dt,
+		Dur:    func() *Duration { v := Duration(dur); return &v }(),
+		UUID:   func() *UUID { v := UUID("a8098c1a-f86e-11da-bd1a-00112444be1e"); return &v }(),
+		Eml:    func() *Email { v := Email("dummy@dummy.com"); return &v }(),
+		Hn:     func() *Hostname { v := Hostname("somewhere.com"); return &v }(),
+		Ipv4:   func() *IPv4 { v := IPv4("192.168.254.1"); return &v }(),
+		Mac:    func() *MAC { v := MAC("01:02:03:04:05:06"); return &v }(),
+		B64Std: func() *Base64Std { v := Base64Std("?????>"); return &v }(),
+		ULID:   &ulid,
+		Port:   func() *PortNumber { v := PortNumber(8080); return &v }(),
+		Epoch:  func() *Epoch { v := Epoch(1700000000); return &v }(),
+		Str:    &str,
+	}
+
+	test := new(pointerStruct)
+	cfg := &mapstructure.DecoderConfig{
+		DecodeHook:       registry.MapStructureHookFunc(),
+		WeaklyTypedInput: false,
+		Result:           test,
+	}
+	d, err := mapstructure.NewDecoder(cfg)
+	require.NoError(t, err)
+	require.NoError(t, d.Decode(m))
+	assert.Equal(t, exp, test)
+
+	t.Run("hook called with a pointer type", func(t *testing.T) {
+		hook, ok := registry.MapStructureHookFunc().(mapstructure.DecodeHookFuncType)
+		require.True(t, ok)
+		stringType := reflect.TypeOf("")
+
+		decoded, err := hook(stringType, reflect.TypeOf(&dt), "2012-03-02T15:06:05.999999999Z")
+		require.NoError(t, err)
+		assert.Equal(t, &dt, decoded)
+
+		decoded, err = hook(stringType, reflect.TypeOf(new(UUID)), "a8098c1a-f86e-11da-bd1a-00112444be1e")
+		require.NoError(t, err)
+		assert.Equal(t, exp.UUID, decoded)
+
+		// pointers to other types are left untouched
+		decoded, err = hook(stringType, reflect.TypeOf(&str), str)
+		require.NoError(t, err)
+		assert.Equal(t, str, decoded)
+
+		_, err = hook(stringType, reflect.TypeOf(&dt), "not a date")
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeHook_Times(t *testing.T) {
+	type record struct {
+		Name      string
+		Birthday  Date
+		Created   DateTime
+		Updated   *DateTime
+		Expires   *Date
+		Validated DateTime
+		Raw       time.Time
+		Unset     *DateTime
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	created := time.Date(2024, 1, 15, 8, 30, 0, 0, time.UTC)
+	updated := time.Date(2024, 1, 16, 22, 0, 0, 0, newYork)
+	expires := time.Date(2025, 1, 15, 23, 59, 59, 0, newYork)
+
+	registry := asFormatRegistry(t, NewFormats())
+	m := map[string]interface{}{
+		"name":      "alice",
+		"birthday":  time.Date(1990, 6, 1, 23, 30, 0, 0, newYork),
+		"created":   created,
+		"updated":   &updated,
+		"expires":   expires,
+		"validated": "2024-01-15T10:00:00Z",
+		"raw":       created,
+	}
+
+	test := new(record)
+	cfg := &mapstructure.DecoderConfig{
+		DecodeHook: registry.MapStructureHookFunc(),
+		Result:     test,
+	}
+	d, err := mapstructure.NewDecoder(cfg)
+	require.NoError(t, err)
+	require.NoError(t, d.Decode(m))
+
+	assert.Equal(t, "alice", test.Name)
+	// dates are truncated to their calendar day
+	assert.Equal(t, Date(time.Date(1990, 6, 1, 0, 0, 0, 0, time.UTC)), test.Birthday)
+	assert.Equal(t, DateTime(created), test.Created)
+	require.NotNil(t, test.Updated)
+	assert.True(t, test.Updated.Equal(DateTime(updated)))
+	require.NotNil(t, test.Expires)
+	assert.Equal(t, "2025-01-15", test.Expires.String())
+	assert.True(t, test.Validated.Equal(DateTime(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))))
+	assert.Equal(t, created, test.Raw)
+	assert.Nil(t, test.Unset)
+
+	t.Run("times are left untouched for unregistered types", func(t *testing.T) {
+		hook, ok := NewSeededFormats(nil, nil).MapStructureHookFunc().(mapstructure.DecodeHookFuncType)
+		require.True(t, ok)
+		decoded, err := hook(reflect.TypeOf(created), reflect.TypeOf(DateTime{}), created)
+		require.NoError(t, err)
+		assert.Equal(t, created, decoded)
+
+		hook, ok = registry.MapStructureHookFunc().(mapstructure.DecodeHookFuncType)
+		require.True(t, ok)
+		decoded, err = hook(reflect.TypeOf(created), reflect.TypeOf(Epoch(0)), created)
+		require.NoError(t, err)
+		assert.Equal(t, created, decoded)
+
+		var nilTime *time.Time
+		decoded, err = hook(reflect.TypeOf(nilTime), reflect.TypeOf(&DateTime{}), nilTime)
+		require.NoError(t, err)
+		assert.Equal(t, nilTime, decoded)
+	})
+}
+
 func TestDecodeDateTimeHook(t *testing.T) {
 	testCases := []struct {
 		Name  string
@@ -244,7 +1006,7 @@ func TestDecodeDateTimeHook(t *testing.T) {
 			"2019-01-01abc",
 		},
 	}
-	registry := NewFormats()
+	registry := asFormatRegistry(t, NewFormats())
 	type layout struct {
 		DateTime *DateTime `json:"datetime,omitempty"`
 	}
@@ -282,7 +1044,7 @@ func TestDecode_ULID_Hook_Negative(t *testing.T) {
 			"8000000000YYYYYYYYYYYYYYYY",
 		},
 	}
-	registry := NewFormats()
+	registry := asFormatRegistry(t, NewFormats())
 	type layout struct {
 		ULID *ULID `json:"ulid,omitempty"`
 	}
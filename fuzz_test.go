@@ -0,0 +1,262 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+
+package strfmt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// validatorFor returns the validator registered in the Default registry for formats without a public Is* predicate
+func validatorFor(name string) func(string) bool {
+	return func(str string) bool {
+		return Default.Validates(name, str)
+	}
+}
+
+var (
+	isURI  = validatorFor("uri")
+	isIPv4 = validatorFor("ipv4")
+	isIPv6 = validatorFor("ipv6")
+	isCIDR = validatorFor("cidr")
+)
+
+// fuzzFormat checks that any input accepted by the validator may be unmarshaled without error
+func fuzzFormat(f *testing.F, seeds []string, isValid func(string) bool, newFormat func() Format) {
+	f.Helper()
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		if !isValid(input) {
+			return
+		}
+		if err := newFormat().UnmarshalText([]byte(input)); err != nil {
+			t.Errorf("valid input %q could not be unmarshaled: %v", input, err)
+		}
+	})
+}
+
+func FuzzIsEmail(f *testing.F) {
+	seeds := []string{
+		"blah@gmail.com",
+		"email+tag@gmail.com",
+		"\" \"@example.com",
+		"\"Abc\\\\@def\"@example.com",
+		"postmaster@☁→❄→☃→☀→☺→☂→☹→✝.ws",
+		"root@localhost",
+		"somebody@somewhere@com",
+		"Name <a@b.c>",
+		"",
+	}
+
+	fuzzFormat(f, seeds, IsEmail, func() Format { return new(Email) })
+}
+
+func FuzzIsHostname(f *testing.F) {
+	seeds := []string{
+		"somewhere.com",
+		"www.example-hyphenated.org",
+		"xn--bcher-kva.example.com",
+		"www.詹姆斯.org",
+		"localhost",
+		"a.b.c.d.e.f.g.dot",
+		"somewhere.com!",
+		"1.1.1.1",
+		"www.example-.org",
+		"-www.example.org",
+		"www.example_underscored.org",
+		"",
+	}
+
+	fuzzFormat(f, seeds, IsHostname, func() Format { return new(Hostname) })
+}
+
+func FuzzIsURI(f *testing.F) {
+	seeds := []string{
+		"http://somewhere.com",
+		"https://user@example.com:8443/a/b%20c?x=1&y=2#frag",
+		"/relative/path",
+		"somewhere.com",
+		"http://[::1",
+		"",
+	}
+
+	fuzzFormat(f, seeds, isURI, func() Format { return new(URI) })
+}
+
+func FuzzIsUUID(f *testing.F) {
+	seeds := []string{
+		"a8098c1a-f86e-11da-bd1a-00112444be1e",
+		"025b0d74-00a2-4048-bf57-227c5111bb34",
+		"025b0d7400a24048bf57227c5111bb34",
+		"{025b0d74-00a2-4048-bf57-227c5111bb34}",
+		"urn:uuid:025b0d74-00a2-4048-bf57-227c5111bb34",
+		"not-a-uuid",
+		"025b0d74-00a2-4048-bf57-227c5111bb3",
+		"",
+	}
+
+	fuzzFormat(f, seeds, IsUUID, func() Format { return new(UUID) })
+}
+
+func FuzzIsULID(f *testing.F) {
+	seeds := []string{
+		"01EYXZVGBHG26MFTG4JWR4K558",
+		"7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		"00000000000000000000000000",
+		"8000000000YYYYYYYYYYYYYYYY",
+		"01EYXZVGBHG26MFTG4JWR4K55",
+		"",
+	}
+
+	fuzzFormat(f, seeds, IsULID, func() Format { return new(ULID) })
+}
+
+func FuzzIsDate(f *testing.F) {
+	seeds := []string{
+		"2014-12-15",
+		"1970-01-01",
+		"2012-02-29",
+		"2013-02-29",
+		"2014-12-32",
+		"20141215",
+		"2014-12-15T00:00:00Z",
+		"",
+	}
+
+	fuzzFormat(f, seeds, IsDate, func() Format { return new(Date) })
+}
+
+func FuzzIsDateTime(f *testing.F) {
+	seeds := []string{
+		"2014-12-15T08:00:00.000Z",
+		"2012-03-02T15:06:05.999999999Z",
+		"2011-08-18T19:03:37.000000000+01:00",
+		"2014-12-15T19:30:20Z",
+		"2014-12-15 08:00:00",
+		"2019-01-01abc",
+		"2014-12-15",
+		"",
+	}
+
+	fuzzFormat(f, seeds, IsDateTime, func() Format { return new(DateTime) })
+}
+
+func FuzzIsIPv4(f *testing.F) {
+	seeds := []string{
+		"192.168.254.1",
+		"0.0.0.0",
+		"255.255.255.255",
+		"198.168.254.2.2",
+		"256.0.0.1",
+		"::1",
+		"",
+	}
+
+	fuzzFormat(f, seeds, isIPv4, func() Format { return new(IPv4) })
+}
+
+func FuzzIsIPv6(f *testing.F) {
+	seeds := []string{
+		"::1",
+		"2001:db8:a0b:12f0::1",
+		"fe80::1%eth0",
+		"::ffff:192.0.2.1",
+		"127.0.0.1",
+		"2001:db8::g",
+		"",
+	}
+
+	fuzzFormat(f, seeds, isIPv6, func() Format { return new(IPv6) })
+}
+
+func FuzzIsCIDR(f *testing.F) {
+	seeds := []string{
+		"192.0.2.1/24",
+		"2001:db8:a0b:12f0::1/32",
+		"192.168.254.2/24",
+		"198.168.254.2",
+		"2001:db8:a0b:12f0::1",
+		"192.0.2.1/33",
+		"",
+	}
+
+	fuzzFormat(f, seeds, isCIDR, func() Format { return new(CIDR) })
+}
+
+func FuzzULIDUUIDRoundTrip(f *testing.F) {
+	for _, seed := range [][]byte{
+		make([]byte, 16),
+		bytes.Repeat([]byte{0xff}, 16),
+		{0x01, 0x56, 0x3e, 0x3a, 0xb5, 0xd3, 0xd6, 0x76, 0x4c, 0x61, 0xef, 0xb9, 0x93, 0x02, 0xbd, 0x5b},
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		if len(input) != 16 {
+			return
+		}
+		var u ULID
+		copy(u.ULID[:], input)
+
+		id, err := u.ToUUID()
+		if err != nil {
+			t.Fatalf("ULID %v could not be converted to a UUID: %v", u, err)
+		}
+		back, err := id.ToULID()
+		if err != nil {
+			t.Fatalf("UUID %q could not be converted to a ULID: %v", id, err)
+		}
+		if back != u {
+			t.Errorf("round-trip of ULID %v through UUID %q returned %v", u, id, back)
+		}
+		if again, _ := back.ToUUID(); again != id {
+			t.Errorf("round-trip of UUID %q through ULID returned %q", id, again)
+		}
+	})
+}
+
+func FuzzWildcardHostnameMatches(f *testing.F) {
+	for _, seed := range [][2]string{
+		{"*.example.com", "www.example.com"},
+		{"*.example.com", ".example.com"},
+		{"*.example.com.", "www..example.com"},
+		{"www.example.com", "www.example.com."},
+		{"*.", "."},
+		{"*", ""},
+	} {
+		f.Add(seed[0], seed[1])
+	}
+
+	f.Fuzz(func(t *testing.T, pattern, hostname string) {
+		if !WildcardHostname(pattern).Matches(Hostname(hostname)) {
+			return
+		}
+
+		// a wildcard never matches the root, nor a hostname with an empty label
+		labels := strings.Split(strings.TrimSuffix(hostname, "."), ".")
+		for _, label := range labels {
+			if label == "" {
+				t.Fatalf("%q matched %q, which has an empty label", pattern, hostname)
+			}
+		}
+	})
+}
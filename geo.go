@@ -0,0 +1,229 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// GeoCoordinatePattern to check a latitude/longitude pair with up to 8 decimal places
+	GeoCoordinatePattern = `^[+-]?[0-9]{1,2}(\.[0-9]{1,8})?,[+-]?[0-9]{1,3}(\.[0-9]{1,8})?$`
+
+	// geoCoordinateDecimals is the maximum number of decimal places of a coordinate, as per GeoCoordinatePattern
+	geoCoordinateDecimals = 8
+
+	// earthRadius is the mean radius of the earth, in meters
+	earthRadius = 6371008.8
+)
+
+var rxGeoCoordinate = regexp.MustCompile(GeoCoordinatePattern)
+
+func init() {
+	gc := GeoCoordinate("")
+	// register this format in the default registry
+	addBuiltin("geo-coordinate", &gc, IsGeoCoordinate)
+}
+
+// parseGeoCoordinate returns the latitude and longitude, in degrees
+func parseGeoCoordinate(str string) (lat, lon float64, err error) {
+	if !rxGeoCoordinate.MatchString(str) {
+		return 0, 0, fmt.Errorf("invalid geo coordinate: %q", str)
+	}
+	latStr, lonStr, _ := strings.Cut(str, ",")
+	if lat, err = strconv.ParseFloat(latStr, 64); err != nil {
+		return 0, 0, err
+	}
+	if lon, err = strconv.ParseFloat(lonStr, 64); err != nil {
+		return 0, 0, err
+	}
+	if lat < -90 || lat > 90 {
+		return 0, 0, fmt.Errorf("latitude out of range [-90, 90]: %v", lat)
+	}
+	if lon < -180 || lon > 180 {
+		return 0, 0, fmt.Errorf("longitude out of range [-180, 180]: %v", lon)
+	}
+	return lat, lon, nil
+}
+
+// formatGeoDegrees formats decimal degrees with at most 8 decimal places, as accepted by IsGeoCoordinate
+func formatGeoDegrees(deg float64) string {
+	str := strconv.FormatFloat(deg, 'f', geoCoordinateDecimals, 64)
+	str = strings.TrimRight(str, "0")
+	return strings.TrimSuffix(str, ".")
+}
+
+// IsGeoCoordinate returns true when the string is a valid "<lat>,<lon>" pair of decimal degrees.
+//
+// The latitude must be in [-90, 90], the longitude in [-180, 180], and both have up to 8 decimal places.
+func IsGeoCoordinate(str string) bool {
+	_, _, err := parseGeoCoordinate(str)
+	return err == nil
+}
+
+// GeoCoordinate represents a "<lat>,<lon>" pair of decimal degrees (e.g. "48.8566,2.3522")
+//
+// swagger:strfmt geo-coordinate
+type GeoCoordinate string
+
+type geoCoordinateObject struct {
+	Lat *float64 `json:"lat"`
+	Lon *float64 `json:"lon"`
+}
+
+// Latitude returns the latitude, in degrees
+func (g GeoCoordinate) Latitude() (float64, error) {
+	lat, _, err := parseGeoCoordinate(string(g))
+	return lat, err
+}
+
+// Longitude returns the longitude, in degrees
+func (g GeoCoordinate) Longitude() (float64, error) {
+	_, lon, err := parseGeoCoordinate(string(g))
+	return lon, err
+}
+
+// Distance returns the great-circle distance to another coordinate, in meters.
+//
+// The distance is computed with the haversine formula, assuming a spherical earth.
+func (g GeoCoordinate) Distance(other GeoCoordinate) (float64, error) {
+	lat1, lon1, err := parseGeoCoordinate(string(g))
+	if err != nil {
+		return 0, err
+	}
+	lat2, lon2, err := parseGeoCoordinate(string(other))
+	if err != nil {
+		return 0, err
+	}
+
+	const toRadians = math.Pi / 180
+	phi1, phi2 := lat1*toRadians, lat2*toRadians
+	dPhi := (lat2 - lat1) * toRadians
+	dLambda := (lon2 - lon1) * toRadians
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a))), nil
+}
+
+// MarshalText turns this instance into text
+func (g GeoCoordinate) MarshalText() ([]byte, error) {
+	return []byte(string(g)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (g *GeoCoordinate) UnmarshalText(data []byte) error { // validation is performed later on
+	*g = GeoCoordinate(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (g *GeoCoordinate) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*g = GeoCoordinate(string(v))
+	case string:
+		*g = GeoCoordinate(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.GeoCoordinate from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (g GeoCoordinate) Value() (driver.Value, error) {
+	return driver.Value(string(g)), nil
+}
+
+func (g GeoCoordinate) String() string {
+	return string(g)
+}
+
+// MarshalJSON returns the GeoCoordinate as a JSON string
+func (g GeoCoordinate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(g))
+}
+
+// UnmarshalJSON sets the GeoCoordinate from JSON.
+//
+// Both the string form (e.g. "48.8566,2.3522") and the object form (e.g. {"lat": 48.8566, "lon": 2.3522}) are supported.
+func (g *GeoCoordinate) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var obj geoCoordinateObject
+		if err := json.Unmarshal(trimmed, &obj); err != nil {
+			return err
+		}
+		if obj.Lat == nil || obj.Lon == nil {
+			return errors.New("a geo coordinate object requires both \"lat\" and \"lon\"")
+		}
+		*g = GeoCoordinate(formatGeoDegrees(*obj.Lat) + "," + formatGeoDegrees(*obj.Lon))
+		return nil
+	}
+
+	var gstr string
+	if err := json.Unmarshal(data, &gstr); err != nil {
+		return err
+	}
+	*g = GeoCoordinate(gstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (g GeoCoordinate) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": g.String()})
+}
+
+// UnmarshalBSON document into this value
+func (g *GeoCoordinate) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*g = GeoCoordinate(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as GeoCoordinate")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (g *GeoCoordinate) DeepCopyInto(out *GeoCoordinate) {
+	*out = *g
+}
+
+// DeepCopy copies the receiver into a new GeoCoordinate.
+func (g *GeoCoordinate) DeepCopy() *GeoCoordinate {
+	if g == nil {
+		return nil
+	}
+	out := new(GeoCoordinate)
+	g.DeepCopyInto(out)
+	return out
+}
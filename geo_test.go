@@ -0,0 +1,156 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatGeoCoordinate(t *testing.T) {
+	coord := GeoCoordinate("0,0")
+	str := "48.8566,2.3522"
+	validCoords := []string{
+		"0,0",
+		"90,180",
+		"-90,-180",
+		"+45.5,-73.56",
+		"51.50735090,-0.12775830",
+		"-33.8688,151.2093",
+		"90.0,180.00000000",
+	}
+	invalidCoords := []string{
+		"",
+		"48.8566",
+		"48.8566,",
+		",2.3522",
+		"48.8566, 2.3522",
+		"48.8566;2.3522",
+		"90.00000001,0",
+		"-91,0",
+		"0,180.1",
+		"0,-181",
+		"1.123456789,0",
+		"0,1.123456789",
+		"100,0",
+		"0,1000",
+		"abc,def",
+		"1e1,2",
+		".5,2",
+		"48.,2",
+	}
+
+	testStringFormat(t, &coord, "geo-coordinate", str, validCoords, invalidCoords)
+}
+
+func TestGeoCoordinate_LatLon(t *testing.T) {
+	coord := GeoCoordinate("48.8566,-2.3522")
+	lat, err := coord.Latitude()
+	require.NoError(t, err)
+	assert.InDelta(t, 48.8566, lat, 1e-9)
+	lon, err := coord.Longitude()
+	require.NoError(t, err)
+	assert.InDelta(t, -2.3522, lon, 1e-9)
+
+	_, err = GeoCoordinate("91,0").Latitude()
+	require.Error(t, err)
+	_, err = GeoCoordinate("0,181").Longitude()
+	require.Error(t, err)
+}
+
+func TestGeoCoordinate_Distance(t *testing.T) {
+	paris := GeoCoordinate("48.8566,2.3522")
+	london := GeoCoordinate("51.5074,-0.1278")
+
+	d, err := paris.Distance(london)
+	require.NoError(t, err)
+	assert.InDelta(t, 343_500, d, 1_000)
+
+	back, err := london.Distance(paris)
+	require.NoError(t, err)
+	assert.InDelta(t, d, back, 1e-6)
+
+	d, err = paris.Distance(paris)
+	require.NoError(t, err)
+	assert.InDelta(t, 0, d, 1e-9)
+
+	// antipodal points are half the circumference apart
+	d, err = GeoCoordinate("0,0").Distance("0,180")
+	require.NoError(t, err)
+	assert.InDelta(t, 20_015_115, d, 1_000)
+
+	_, err = paris.Distance("invalid")
+	require.Error(t, err)
+	_, err = GeoCoordinate("invalid").Distance(paris)
+	require.Error(t, err)
+}
+
+func TestGeoCoordinate_UnmarshalJSONObject(t *testing.T) {
+	var coord GeoCoordinate
+	require.NoError(t, coord.UnmarshalJSON([]byte(`{"lat": 48.8566, "lon": 2.3522}`)))
+	assert.Equal(t, GeoCoordinate("48.8566,2.3522"), coord)
+	assert.True(t, IsGeoCoordinate(coord.String()))
+
+	require.NoError(t, coord.UnmarshalJSON([]byte(` {"lon": -180, "lat": -90} `)))
+	assert.Equal(t, GeoCoordinate("-90,-180"), coord)
+
+	b, err := coord.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"-90,-180"`, string(b))
+
+	// coordinates are rounded to the 8 decimal places accepted by the string form
+	for _, tc := range []struct {
+		object   string
+		expected GeoCoordinate
+	}{
+		{`{"lat": 48.856613456789, "lon": 2.352221987654}`, "48.85661346,2.35222199"},
+		{`{"lat": 1e-9, "lon": 0.00000001}`, "0,0.00000001"},
+		{`{"lat": 12.5, "lon": 1e2}`, "12.5,100"},
+	} {
+		require.NoError(t, coord.UnmarshalJSON([]byte(tc.object)))
+		assert.Equal(t, tc.expected, coord)
+		assert.Truef(t, IsGeoCoordinate(coord.String()), "expected %s to be valid", coord)
+
+		b, err = json.Marshal(coord)
+		require.NoError(t, err)
+		var roundTrip GeoCoordinate
+		require.NoError(t, json.Unmarshal(b, &roundTrip))
+		assert.Equal(t, coord, roundTrip)
+	}
+
+	require.Error(t, coord.UnmarshalJSON([]byte(`{"lat": 48.8566}`)))
+	require.Error(t, coord.UnmarshalJSON([]byte(`{"lat": "48.8566", "lon": "2.3522"}`)))
+	require.Error(t, coord.UnmarshalJSON([]byte(`{"lat": 48.8566,`)))
+	require.Error(t, coord.UnmarshalJSON([]byte(`[48.8566, 2.3522]`)))
+}
+
+func TestDeepCopyGeoCoordinate(t *testing.T) {
+	coord := GeoCoordinate("48.8566,2.3522")
+	in := &coord
+
+	out := new(GeoCoordinate)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *GeoCoordinate
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
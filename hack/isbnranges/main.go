@@ -0,0 +1,82 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+// This program downloads the ISBN range message published by the International ISBN Agency,
+// to be embedded by strfmt when building with the isbnranges tag.
+//
+// Usage (see go:generate in isbnrange.go):
+//
+//	go run hack/isbnranges/main.go -o isbnranges/RangeMessage.xml
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+)
+
+const rangeMessageURL = "https://www.isbn-international.org/export_rangemessage.xml"
+
+// rangeMessage is the part of the range message checked before it is written
+type rangeMessage struct {
+	XMLName  xml.Name   `xml:"ISBNRangeMessage"`
+	Prefixes []struct{} `xml:"EAN.UCCPrefixes>EAN.UCC"`
+	Groups   []struct{} `xml:"RegistrationGroups>Group"`
+}
+
+func main() {
+	output := flag.String("o", "RangeMessage.xml", "output file")
+	source := flag.String("url", rangeMessageURL, "URL of the range message")
+	flag.Parse()
+
+	data, err := download(*source)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var msg rangeMessage
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&msg); err != nil {
+		log.Fatalf("invalid ISBN range message: %v", err)
+	}
+	if len(msg.Prefixes) == 0 || len(msg.Groups) == 0 {
+		log.Fatal("invalid ISBN range message: no EAN.UCC prefix or registration group")
+	}
+
+	if err := os.WriteFile(*output, data, 0o600); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%s: %d EAN.UCC prefixes, %d registration groups", *output, len(msg.Prefixes), len(msg.Groups))
+}
+
+func download(url string) ([]byte, error) {
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,189 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	bsonprim "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	h := HexString("")
+	// register this format in the default registry
+	addBuiltin("hex", &h, IsHexString)
+}
+
+// IsHexString returns true when the string is made of an even number of hexadecimal characters (e.g. "cafe01").
+//
+// Both lower and upper case characters are accepted.
+func IsHexString(str string) bool {
+	if len(str)%2 != 0 {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') && (c < 'A' || c > 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// HexStringN returns a validator for hex strings which decode to exactly n bytes.
+//
+// It may be used to register formats for fixed size values, such as digests:
+//
+//	strfmt.Default.Add("sha256", new(strfmt.HexString), strfmt.HexStringN(32))
+func HexStringN(n int) Validator {
+	return func(str string) bool {
+		return IsHexString(str) && len(str) == 2*n
+	}
+}
+
+// HexString represents a sequence of bytes encoded as hexadecimal characters,
+// such as keys, fingerprints or digests (e.g. "d41d8cd98f00b204e9800998ecf8427e")
+//
+// swagger:strfmt hex
+type HexString string
+
+// Bytes decodes this hex string
+func (h HexString) Bytes() ([]byte, error) {
+	return hex.DecodeString(string(h))
+}
+
+// WithLength returns true when this is a valid hex string which decodes to exactly n bytes
+func (h HexString) WithLength(n int) bool {
+	return HexStringN(n)(string(h))
+}
+
+// MarshalText turns this instance into text
+func (h HexString) MarshalText() ([]byte, error) {
+	return []byte(string(h)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (h *HexString) UnmarshalText(data []byte) error { // validation is performed later on
+	*h = HexString(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (h *HexString) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*h = HexString(string(v))
+	case string:
+		*h = HexString(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.HexString from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (h HexString) Value() (driver.Value, error) {
+	return driver.Value(string(h)), nil
+}
+
+func (h HexString) String() string {
+	return string(h)
+}
+
+// MarshalJSON returns the HexString as JSON
+func (h HexString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(h))
+}
+
+// UnmarshalJSON sets the HexString from JSON
+func (h *HexString) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var hstr string
+	if err := json.Unmarshal(data, &hstr); err != nil {
+		return err
+	}
+	*h = HexString(hstr)
+	return nil
+}
+
+// MarshalBSON document from this value, with the decoded bytes stored as BSON binary data
+func (h HexString) MarshalBSON() ([]byte, error) {
+	b, err := h.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return bson.Marshal(bson.M{"data": bsonprim.Binary{Subtype: bsontype.BinaryGeneric, Data: b}})
+}
+
+// UnmarshalBSON document into this value.
+//
+// The hex string is rendered with lower case characters.
+func (h *HexString) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(bsonprim.Binary); ok {
+		*h = HexString(hex.EncodeToString(ud.Data))
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as HexString")
+}
+
+// MarshalBSONValue marshals this HexString as BSON generic binary data
+func (h HexString) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	b, err := h.Bytes()
+	if err != nil {
+		return bsontype.Type(0), nil, err
+	}
+	return marshalBSONBinary(b)
+}
+
+// UnmarshalBSONValue reads this HexString from BSON binary data.
+//
+// The hex string is rendered with lower case characters.
+func (h *HexString) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	b, err := unmarshalBSONBinary(tpe, data, "HexString", hex.DecodeString)
+	if err != nil {
+		return err
+	}
+	*h = HexString(hex.EncodeToString(b))
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (h *HexString) DeepCopyInto(out *HexString) {
+	*out = *h
+}
+
+// DeepCopy copies the receiver into a new HexString.
+func (h *HexString) DeepCopy() *HexString {
+	if h == nil {
+		return nil
+	}
+	out := new(HexString)
+	h.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,106 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestFormatHexString(t *testing.T) {
+	h := HexString("cafe")
+	str := "d41d8cd98f00b204e9800998ecf8427e"
+	validHex := []string{
+		"",
+		"00",
+		"cafe",
+		"CAFE",
+		"CaFe01",
+		"0123456789abcdefABCDEF",
+	}
+	invalidHex := []string{
+		"0",
+		"abc",
+		"0x00",
+		"zz",
+		"ca fe",
+		"ca:fe",
+		"é0",
+	}
+
+	testStringFormat(t, &h, "hex", str, validHex, invalidHex)
+}
+
+func TestHexString_Bytes(t *testing.T) {
+	b, err := HexString("CAFE01").Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xca, 0xfe, 0x01}, b)
+
+	_, err = HexString("cafe0").Bytes()
+	require.Error(t, err)
+
+	assert.True(t, HexString("cafe01").WithLength(3))
+	assert.False(t, HexString("cafe01").WithLength(2))
+	assert.False(t, HexString("cafe0x").WithLength(3))
+	assert.True(t, HexString("").WithLength(0))
+}
+
+func TestHexStringN(t *testing.T) {
+	registry := asFormatRegistry(t, NewFormats())
+	registry.Add("md5", new(HexString), HexStringN(16))
+
+	assert.True(t, registry.Validates("md5", "d41d8cd98f00b204e9800998ecf8427e"))
+	assert.False(t, registry.Validates("md5", "d41d8cd98f00b204e9800998ecf842"))
+	assert.False(t, registry.Validates("md5", "d41d8cd98f00b204e9800998ecf8427e00"))
+	assert.False(t, registry.Validates("md5", "z41d8cd98f00b204e9800998ecf8427e"))
+}
+
+func TestHexString_BSONValue(t *testing.T) {
+	type withHex struct {
+		Key HexString `bson:"key"`
+	}
+
+	data, err := bson.Marshal(withHex{Key: "CAFE01"})
+	require.NoError(t, err)
+	subtype, b := bson.Raw(data).Lookup("key").Binary()
+	assert.Zero(t, subtype)
+	assert.Equal(t, []byte{0xca, 0xfe, 0x01}, b)
+
+	var out withHex
+	require.NoError(t, bson.Unmarshal(data, &out))
+	assert.Equal(t, HexString("cafe01"), out.Key)
+
+	_, err = bson.Marshal(withHex{Key: "not hex"})
+	require.Error(t, err)
+}
+
+func TestDeepCopyHexString(t *testing.T) {
+	h := HexString("cafe")
+	in := &h
+
+	out := new(HexString)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *HexString
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
@@ -0,0 +1,80 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"fmt"
+	"math"
+)
+
+// rgbOrError returns the channels of this color, or an error when it is not a valid hex color
+func (h HexColor) rgbOrError() (r, g, b uint8, err error) {
+	r, g, b, ok := h.rgb()
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("invalid hex color: %q", string(h))
+	}
+	return r, g, b, nil
+}
+
+// hexColorFromRGB formats color channels as a hex color, e.g. "#663399"
+func hexColorFromRGB(r, g, b uint8) HexColor {
+	return HexColor("#" + hex2(r) + hex2(g) + hex2(b))
+}
+
+// Invert returns the complementary color of this color, e.g. "#00ffff" for "#FF0000"
+func (h HexColor) Invert() (HexColor, error) {
+	r, g, b, err := h.rgbOrError()
+	if err != nil {
+		return "", err
+	}
+	return hexColorFromRGB(255-r, 255-g, 255-b), nil
+}
+
+// Grayscale returns the gray with the same luminosity as this color,
+// using the ITU-R BT.601 luma coefficients (0.299R + 0.587G + 0.114B)
+func (h HexColor) Grayscale() (HexColor, error) {
+	r, g, b, err := h.rgbOrError()
+	if err != nil {
+		return "", err
+	}
+	y := uint8(math.Round(0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)))
+	return hexColorFromRGB(y, y, y), nil
+}
+
+// Darken returns this color with its HSL lightness reduced by the specified proportion, in [0,1].
+//
+// E.g. darkening by 0.5 halves the lightness, and darkening by 1 yields black.
+func (h HexColor) Darken(amount float64) (HexColor, error) {
+	return h.adjustLightness(amount, func(l float64) float64 { return l * (1 - amount) })
+}
+
+// Lighten returns this color with its HSL lightness moved toward white by the specified proportion, in [0,1].
+//
+// E.g. lightening by 0.5 halves the distance to white, and lightening by 1 yields white.
+func (h HexColor) Lighten(amount float64) (HexColor, error) {
+	return h.adjustLightness(amount, func(l float64) float64 { return l + (100-l)*amount })
+}
+
+func (h HexColor) adjustLightness(amount float64, adjust func(float64) float64) (HexColor, error) {
+	if math.IsNaN(amount) || amount < 0 || amount > 1 {
+		return "", fmt.Errorf("invalid amount %v: must be between 0 and 1", amount)
+	}
+	r, g, b, err := h.rgbOrError()
+	if err != nil {
+		return "", err
+	}
+	hue, s, l := rgbToHSL(r, g, b)
+	return hexColorFromRGB(hslToRGB(hue, s, adjust(l))), nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHexColor_Invert(t *testing.T) {
+	for _, tc := range []struct {
+		color, expected HexColor
+	}{
+		{"#FF0000", "#00ffff"},
+		{"#000", "#ffffff"},
+		{"#663399", "#99cc66"},
+	} {
+		inverted, err := tc.color.Invert()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, inverted)
+	}
+
+	_, err := HexColor("red").Invert()
+	require.Error(t, err)
+}
+
+func TestHexColor_Grayscale(t *testing.T) {
+	for _, tc := range []struct {
+		color, expected HexColor
+	}{
+		{"#FF0000", "#4c4c4c"},
+		{"#00FF00", "#969696"},
+		{"#0000FF", "#1d1d1d"},
+		{"#fff", "#ffffff"},
+		{"#808080", "#808080"},
+	} {
+		gray, err := tc.color.Grayscale()
+		require.NoError(t, err)
+		assert.Equalf(t, tc.expected, gray, "unexpected grayscale of %s", tc.color)
+	}
+
+	_, err := HexColor("#12345").Grayscale()
+	require.Error(t, err)
+}
+
+func TestHexColor_DarkenLighten(t *testing.T) {
+	darker, err := HexColor("#FF0000").Darken(0.5)
+	require.NoError(t, err)
+	assert.Equal(t, HexColor("#800000"), darker)
+
+	lighter, err := HexColor("#FF0000").Lighten(0.5)
+	require.NoError(t, err)
+	assert.Equal(t, HexColor("#ff8080"), lighter)
+
+	for _, color := range []HexColor{"#663399", "#000", "#fff"} {
+		same, err := color.Darken(0)
+		require.NoError(t, err)
+		expected, err := color.ToHSL()
+		require.NoError(t, err)
+		actual, err := same.ToHSL()
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		black, err := color.Darken(1)
+		require.NoError(t, err)
+		assert.Equal(t, HexColor("#000000"), black)
+
+		white, err := color.Lighten(1)
+		require.NoError(t, err)
+		assert.Equal(t, HexColor("#ffffff"), white)
+	}
+
+	for _, amount := range []float64{-0.1, 1.1, math.NaN()} {
+		_, err = HexColor("#663399").Darken(amount)
+		require.Error(t, err)
+		_, err = HexColor("#663399").Lighten(amount)
+		require.Error(t, err)
+	}
+	_, err = HexColor("nope").Darken(0.5)
+	require.Error(t, err)
+}
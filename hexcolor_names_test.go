@@ -0,0 +1,84 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHexColor_Names(t *testing.T) {
+	assert.Len(t, cssNamedColors, 148)
+	for name, value := range cssNamedColors {
+		assert.Truef(t, govalidator.IsHexcolor(string(value)), "invalid value %q for %s", value, name)
+		back, ok := HexColorByName(name)
+		assert.True(t, ok)
+		assert.Equal(t, value, back)
+	}
+
+	h, ok := HexColorByName("RebeccaPurple")
+	assert.True(t, ok)
+	assert.Equal(t, HexColor("#663399"), h)
+	_, ok = HexColorByName("transparent")
+	assert.False(t, ok)
+
+	for _, tc := range []struct {
+		color HexColor
+		name  string
+		ok    bool
+	}{
+		{"#663399", "rebeccapurple", true},
+		{"#FF0000", "red", true},
+		{"f00", "red", true},
+		{"#0ff", "aqua", true},
+		{"#808080", "gray", true},
+		{"#123456", "", false},
+		{"#12345", "", false},
+		{"#gggggg", "", false},
+	} {
+		name, ok := tc.color.Name()
+		assert.Equalf(t, tc.ok, ok, "unexpected name lookup result for %q", tc.color)
+		assert.Equal(t, tc.name, name)
+	}
+}
+
+func TestHexColor_ClosestNamedColor(t *testing.T) {
+	for _, tc := range []struct {
+		color HexColor
+		name  string
+	}{
+		{"#ff0000", "red"},
+		{"#fe0101", "red"},
+		{"#000001", "black"},
+		{"#00ffff", "aqua"},
+		{"#663398", "rebeccapurple"},
+		{"#7f7f7f", "gray"},
+		{"#fffffe", "white"},
+		{"not a color", ""},
+	} {
+		assert.Equalf(t, tc.name, tc.color.ClosestNamedColor(), "unexpected closest color for %q", tc.color)
+	}
+}
+
+func BenchmarkHexColor_ClosestNamedColor(b *testing.B) {
+	colors := []HexColor{"#123456", "#fe0101", "#abcdef", "#7f7f7f"}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = colors[i%len(colors)].ClosestNamedColor()
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func init() {
+	hsl := HSLColor("")
+	// register this format in the default registry
+	addBuiltin("hslcolor", &hsl, IsHSLColor)
+}
+
+var (
+	rxHSLColor = regexp.MustCompile(`^hsl\(\s*(\d+(?:\.\d+)?)\s*,\s*(\d+(?:\.\d+)?)%\s*,\s*(\d+(?:\.\d+)?)%\s*\)$`)
+	rxRGBColor = regexp.MustCompile(`^rgb\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*\)$`)
+)
+
+// IsHSLColor returns true when the string is an HSL color such as "hsl(270,50%,40%)",
+// with a hue in [0,360), and a saturation and a lightness in [0,100].
+func IsHSLColor(str string) bool {
+	_, _, _, err := parseHSLColor(str)
+	return err == nil
+}
+
+func parseHSLColor(str string) (h, s, l float64, err error) {
+	m := rxHSLColor.FindStringSubmatch(str)
+	if m == nil {
+		return 0, 0, 0, fmt.Errorf("invalid hsl color: %q", str)
+	}
+	// the regular expression only matches valid floats
+	h, _ = strconv.ParseFloat(m[1], 64)
+	s, _ = strconv.ParseFloat(m[2], 64)
+	l, _ = strconv.ParseFloat(m[3], 64)
+	if h >= 360 || s > 100 || l > 100 {
+		return 0, 0, 0, fmt.Errorf("hsl color out of range: %q", str)
+	}
+	return h, s, l, nil
+}
+
+// formatHSLColor renders an HSL color, rounding its components to one decimal
+func formatHSLColor(h, s, l float64) HSLColor {
+	round := func(v float64) string {
+		return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+	}
+	if h = math.Round(h*10) / 10; h >= 360 {
+		h = 0
+	}
+	return HSLColor("hsl(" + round(h) + "," + round(s) + "%," + round(l) + "%)")
+}
+
+func parseRGBColor(str string) (r, g, b uint8, err error) {
+	m := rxRGBColor.FindStringSubmatch(str)
+	if m == nil {
+		return 0, 0, 0, fmt.Errorf("invalid rgb color: %q", str)
+	}
+	var c [3]uint8
+	for i := range c {
+		v, err := strconv.ParseUint(m[i+1], 10, 8)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("rgb color out of range: %q", str)
+		}
+		c[i] = uint8(v)
+	}
+	return c[0], c[1], c[2], nil
+}
+
+// rgbToHSL converts RGB components to a hue in degrees, a saturation and a lightness in percents
+func rgbToHSL(r, g, b uint8) (h, s, l float64) {
+	rf, gf, bf := float64(r)/255, float64(g)/255, float64(b)/255
+	maxc, minc := math.Max(rf, math.Max(gf, bf)), math.Min(rf, math.Min(gf, bf))
+	l = (maxc + minc) / 2
+	d := maxc - minc
+	if d == 0 {
+		return 0, 0, l * 100
+	}
+
+	s = d / (1 - math.Abs(2*l-1))
+	switch maxc {
+	case rf:
+		h = math.Mod((gf-bf)/d+6, 6)
+	case gf:
+		h = (bf-rf)/d + 2
+	default:
+		h = (rf-gf)/d + 4
+	}
+	return h * 60, s * 100, l * 100
+}
+
+// hslToRGB converts a hue in degrees, a saturation and a lightness in percents to RGB components,
+// as specified by CSS Color Module Level 4
+func hslToRGB(h, s, l float64) (r, g, b uint8) {
+	s, l = s/100, l/100
+	f := func(n float64) uint8 {
+		k := math.Mod(n+h/30, 12)
+		a := s * math.Min(l, 1-l)
+		v := l - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+		return uint8(math.Round(v * 255))
+	}
+	return f(0), f(8), f(4)
+}
+
+// ToHSL converts this RGB color to an HSL color
+func (c RGBColor) ToHSL() (HSLColor, error) {
+	r, g, b, err := parseRGBColor(string(c))
+	if err != nil {
+		return "", err
+	}
+	return formatHSLColor(rgbToHSL(r, g, b)), nil
+}
+
+// ToHSL converts this hex color to an HSL color
+func (h HexColor) ToHSL() (HSLColor, error) {
+	r, g, b, ok := h.rgb()
+	if !ok {
+		return "", fmt.Errorf("invalid hex color: %q", string(h))
+	}
+	return formatHSLColor(rgbToHSL(r, g, b)), nil
+}
+
+// HSLColor represents a CSS color in the HSL notation (e.g. "hsl(270,50%,40%)")
+//
+// swagger:strfmt hslcolor
+type HSLColor string
+
+// ToRGB converts this HSL color to an RGB color
+func (c HSLColor) ToRGB() (RGBColor, error) {
+	h, s, l, err := parseHSLColor(string(c))
+	if err != nil {
+		return "", err
+	}
+	r, g, b := hslToRGB(h, s, l)
+	return RGBColor(fmt.Sprintf("rgb(%d,%d,%d)", r, g, b)), nil
+}
+
+// ToHex converts this HSL color to a hex color (e.g. "#663399")
+func (c HSLColor) ToHex() (HexColor, error) {
+	h, s, l, err := parseHSLColor(string(c))
+	if err != nil {
+		return "", err
+	}
+	r, g, b := hslToRGB(h, s, l)
+	return hexColorFromRGB(r, g, b), nil
+}
+
+// Rotate returns this color with its hue rotated by the specified angle in degrees, which may be negative.
+//
+// An invalid HSL color is returned unchanged.
+func (c HSLColor) Rotate(degrees float64) HSLColor {
+	h, s, l, err := parseHSLColor(string(c))
+	if err != nil {
+		return c
+	}
+	h = math.Mod(h+degrees, 360)
+	if h < 0 {
+		h += 360
+	}
+	return formatHSLColor(h, s, l)
+}
+
+// MarshalText turns this instance into text
+func (c HSLColor) MarshalText() ([]byte, error) {
+	return []byte(string(c)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (c *HSLColor) UnmarshalText(data []byte) error { // validation is performed later on
+	*c = HSLColor(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (c *HSLColor) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*c = HSLColor(string(v))
+	case string:
+		*c = HSLColor(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.HSLColor from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (c HSLColor) Value() (driver.Value, error) {
+	return driver.Value(string(c)), nil
+}
+
+func (c HSLColor) String() string {
+	return string(c)
+}
+
+// MarshalJSON returns the HSLColor as JSON
+func (c HSLColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+// UnmarshalJSON sets the HSLColor from JSON
+func (c *HSLColor) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var cstr string
+	if err := json.Unmarshal(data, &cstr); err != nil {
+		return err
+	}
+	*c = HSLColor(cstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (c HSLColor) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": c.String()})
+}
+
+// UnmarshalBSON document into this value
+func (c *HSLColor) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(string); ok {
+		*c = HSLColor(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as HSLColor")
+}
+
+// MarshalBSONValue marshals this HSLColor as a BSON string
+func (c HSLColor) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(c))
+}
+
+// UnmarshalBSONValue reads this HSLColor from a BSON string
+func (c *HSLColor) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "HSLColor")
+	if err != nil {
+		return err
+	}
+	*c = HSLColor(str)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (c *HSLColor) DeepCopyInto(out *HSLColor) {
+	*out = *c
+}
+
+// DeepCopy copies the receiver into a new HSLColor.
+func (c *HSLColor) DeepCopy() *HSLColor {
+	if c == nil {
+		return nil
+	}
+	out := new(HSLColor)
+	c.DeepCopyInto(out)
+	return out
+}
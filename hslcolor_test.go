@@ -0,0 +1,127 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatHSLColor(t *testing.T) {
+	hsl := HSLColor("hsl(270,50%,40%)")
+	str := "hsl(120,100%,25%)"
+	validHSLColors := []string{
+		"hsl(0,0%,0%)",
+		"hsl(359,100%,100%)",
+		"hsl(359.9,99.5%,0.5%)",
+		"hsl( 270 , 50% , 40% )",
+	}
+	invalidHSLColors := []string{
+		"hsl(360,50%,40%)",
+		"hsl(270,101%,40%)",
+		"hsl(270,50%,100.1%)",
+		"hsl(-10,50%,40%)",
+		"hsl(270,50,40)",
+		"hsla(270,50%,40%,1)",
+		"rgb(100,100,100)",
+		"hsl(270,50%)",
+	}
+
+	testStringFormat(t, &hsl, "hslcolor", str, validHSLColors, invalidHSLColors)
+}
+
+// cssColorMappings are CSS named colors (black, white, red, lime, blue, yellow, aqua, fuchsia, green, gray,
+// rebeccapurple, orange) in the HSL and sRGB notations of CSS Color Module Level 4
+var cssColorMappings = []struct {
+	hsl HSLColor
+	rgb RGBColor
+	hex HexColor
+}{
+	{"hsl(0,0%,0%)", "rgb(0,0,0)", "#000000"},
+	{"hsl(0,0%,100%)", "rgb(255,255,255)", "#ffffff"},
+	{"hsl(0,100%,50%)", "rgb(255,0,0)", "#ff0000"},
+	{"hsl(120,100%,50%)", "rgb(0,255,0)", "#00ff00"},
+	{"hsl(240,100%,50%)", "rgb(0,0,255)", "#0000ff"},
+	{"hsl(60,100%,50%)", "rgb(255,255,0)", "#ffff00"},
+	{"hsl(180,100%,50%)", "rgb(0,255,255)", "#00ffff"},
+	{"hsl(300,100%,50%)", "rgb(255,0,255)", "#ff00ff"},
+	{"hsl(120,100%,25.1%)", "rgb(0,128,0)", "#008000"},
+	{"hsl(0,0%,50.2%)", "rgb(128,128,128)", "#808080"},
+	{"hsl(270,50%,40%)", "rgb(102,51,153)", "#663399"},
+	{"hsl(38.8,100%,50%)", "rgb(255,165,0)", "#ffa500"},
+}
+
+func TestHSLColor_Conversions(t *testing.T) {
+	for _, tc := range cssColorMappings {
+		rgb, err := tc.hsl.ToRGB()
+		require.NoError(t, err)
+		assert.Equalf(t, tc.rgb, rgb, "unexpected RGB conversion of %q", tc.hsl)
+
+		hex, err := tc.hsl.ToHex()
+		require.NoError(t, err)
+		assert.Equalf(t, tc.hex, hex, "unexpected hex conversion of %q", tc.hsl)
+
+		hsl, err := tc.rgb.ToHSL()
+		require.NoError(t, err)
+		assert.Equalf(t, tc.hsl, hsl, "unexpected HSL conversion of %q", tc.rgb)
+
+		hsl, err = tc.hex.ToHSL()
+		require.NoError(t, err)
+		assert.Equalf(t, tc.hsl, hsl, "unexpected HSL conversion of %q", tc.hex)
+	}
+
+	hsl, err := RGBColor("rgb( 238 , 130 , 238 )").ToHSL()
+	require.NoError(t, err)
+	assert.Equal(t, HSLColor("hsl(300,76.1%,72.2%)"), hsl)
+	rgb, err := hsl.ToRGB()
+	require.NoError(t, err)
+	assert.Equal(t, RGBColor("rgb(238,130,238)"), rgb)
+
+	_, err = RGBColor("rgb(256,0,0)").ToHSL()
+	require.Error(t, err)
+	_, err = HexColor("#12345").ToHSL()
+	require.Error(t, err)
+	_, err = HSLColor("hsl(360,0%,0%)").ToRGB()
+	require.Error(t, err)
+	_, err = HSLColor("red").ToHex()
+	require.Error(t, err)
+}
+
+func TestHSLColor_Rotate(t *testing.T) {
+	hsl := HSLColor("hsl(270,50%,40%)")
+	assert.Equal(t, HSLColor("hsl(0,50%,40%)"), hsl.Rotate(90))
+	assert.Equal(t, HSLColor("hsl(30.5,50%,40%)"), hsl.Rotate(120.5))
+	assert.Equal(t, HSLColor("hsl(180,50%,40%)"), hsl.Rotate(-90))
+	assert.Equal(t, HSLColor("hsl(270,50%,40%)"), hsl.Rotate(-720))
+	assert.Equal(t, HSLColor("invalid"), HSLColor("invalid").Rotate(90))
+}
+
+func TestDeepCopyHSLColor(t *testing.T) {
+	hsl := HSLColor("hsl(270,50%,40%)")
+	in := &hsl
+
+	out := new(HSLColor)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *HSLColor
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
@@ -0,0 +1,269 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"golang.org/x/net/idna"
+)
+
+// idnaProfile is the IDNA2008 (non-transitional) lookup profile used to validate internationalized hostnames
+var idnaProfile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.Transitional(false),
+	idna.VerifyDNSLength(true),
+)
+
+func init() {
+	idnh := IDNHostname("")
+	// register this format in the default registry
+	addBuiltin("idn-hostname", &idnh, IsIDNHostname)
+
+	idne := IDNEmail("")
+	addBuiltin("idn-email", &idne, IsIDNEmail)
+}
+
+// IsIDNHostname returns true when the string is a valid internationalized hostname, as specified by IDNA2008.
+//
+// As for IsHostname, a single trailing dot denoting a fully qualified domain name is accepted.
+func IsIDNHostname(str string) bool {
+	trimmed := strings.TrimSuffix(str, ".")
+	if trimmed == "" || strings.HasPrefix(trimmed, ".") || strings.Contains(trimmed, "..") || strings.HasSuffix(trimmed, ".") {
+		return false
+	}
+	_, err := idnaProfile.ToASCII(str)
+	return err == nil
+}
+
+// IsIDNEmail returns true when the string is a valid internationalized email address, as specified by RFC 6531.
+//
+// The local part may contain non-ASCII UTF-8 characters, and the domain part must be a valid IDN hostname.
+func IsIDNEmail(str string) bool {
+	addr, err := mail.ParseAddress(str)
+	if err != nil {
+		return false
+	}
+	at := strings.LastIndexByte(addr.Address, '@')
+	if at <= 0 {
+		return false
+	}
+	return IsIDNHostname(addr.Address[at+1:])
+}
+
+// IDNHostname represents an internationalized hostname, as specified by IDNA2008
+//
+// swagger:strfmt idn-hostname
+type IDNHostname string
+
+// MarshalText turns this instance into text
+func (h IDNHostname) MarshalText() ([]byte, error) {
+	return []byte(string(h)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (h *IDNHostname) UnmarshalText(data []byte) error { // validation is performed later on
+	*h = IDNHostname(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (h *IDNHostname) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*h = IDNHostname(string(v))
+	case string:
+		*h = IDNHostname(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IDNHostname from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (h IDNHostname) Value() (driver.Value, error) {
+	return driver.Value(string(h)), nil
+}
+
+func (h IDNHostname) String() string {
+	return string(h)
+}
+
+// MarshalJSON returns the IDNHostname as JSON
+func (h IDNHostname) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(h))
+}
+
+// UnmarshalJSON sets the IDNHostname from JSON
+func (h *IDNHostname) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var hstr string
+	if err := json.Unmarshal(data, &hstr); err != nil {
+		return err
+	}
+	*h = IDNHostname(hstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (h IDNHostname) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": h.String()})
+}
+
+// UnmarshalBSON document into this value
+func (h *IDNHostname) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*h = IDNHostname(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as IDNHostname")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (h *IDNHostname) DeepCopyInto(out *IDNHostname) {
+	*out = *h
+}
+
+// DeepCopy copies the receiver into a new IDNHostname.
+func (h *IDNHostname) DeepCopy() *IDNHostname {
+	if h == nil {
+		return nil
+	}
+	out := new(IDNHostname)
+	h.DeepCopyInto(out)
+	return out
+}
+
+// IDNEmail represents an internationalized email address, as specified by RFC 6531
+//
+// swagger:strfmt idn-email
+type IDNEmail string
+
+// Normalize returns this email address with its domain part converted to its ASCII (IDNA) form.
+//
+// The local part is left untouched. The address is returned unchanged if the domain cannot be converted.
+func (e IDNEmail) Normalize() IDNEmail {
+	str := string(e)
+	at := strings.LastIndexByte(str, '@')
+	if at < 0 {
+		return e
+	}
+	domain, err := idnaProfile.ToASCII(str[at+1:])
+	if err != nil {
+		return e
+	}
+	return IDNEmail(str[:at+1] + domain)
+}
+
+// MarshalText turns this instance into text
+func (e IDNEmail) MarshalText() ([]byte, error) {
+	return []byte(string(e)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (e *IDNEmail) UnmarshalText(data []byte) error { // validation is performed later on
+	*e = IDNEmail(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (e *IDNEmail) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*e = IDNEmail(string(v))
+	case string:
+		*e = IDNEmail(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IDNEmail from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (e IDNEmail) Value() (driver.Value, error) {
+	return driver.Value(string(e)), nil
+}
+
+func (e IDNEmail) String() string {
+	return string(e)
+}
+
+// MarshalJSON returns the IDNEmail as JSON
+func (e IDNEmail) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(e))
+}
+
+// UnmarshalJSON sets the IDNEmail from JSON
+func (e *IDNEmail) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var estr string
+	if err := json.Unmarshal(data, &estr); err != nil {
+		return err
+	}
+	*e = IDNEmail(estr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (e IDNEmail) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": e.String()})
+}
+
+// UnmarshalBSON document into this value
+func (e *IDNEmail) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*e = IDNEmail(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as IDNEmail")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (e *IDNEmail) DeepCopyInto(out *IDNEmail) {
+	*out = *e
+}
+
+// DeepCopy copies the receiver into a new IDNEmail.
+func (e *IDNEmail) DeepCopy() *IDNEmail {
+	if e == nil {
+		return nil
+	}
+	out := new(IDNEmail)
+	e.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatIDNHostname(t *testing.T) {
+	hostname := IDNHostname("bücher.example.com")
+	str := "www.詹姆斯.org"
+	validHostnames := []string{
+		"example.com",
+		"example.com.",
+		"bücher.example.com.",
+		"localhost",
+		"xn--bcher-kva.example.com",
+		"www.élégigôö.org",
+		"ß.de",
+		"Example.COM",
+		"実例.テスト",
+	}
+	invalidHostnames := []string{
+		"",
+		".",
+		"a..b",
+		"x..",
+		"example.com..",
+		".example.com",
+		"-www.example.org",
+		"www-.example.org",
+		"ab--c.example.org",
+		"www.example_underscored.org",
+		"ex$ample",
+		"www.ex ample.org",
+		strings.Repeat("a", 64) + ".com",
+		strings.Repeat("a.", 127) + "com",
+	}
+
+	testStringFormat(t, &hostname, "idn-hostname", str, validHostnames, invalidHostnames)
+
+	// IDNA2008 is stricter than the hostname format
+	assert.True(t, IsHostname("ex$ample"))
+	assert.False(t, IsIDNHostname("ex$ample"))
+}
+
+func TestDeepCopyIDNHostname(t *testing.T) {
+	hostname := IDNHostname("bücher.example.com")
+	in := &hostname
+
+	out := new(IDNHostname)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *IDNHostname
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestFormatIDNEmail(t *testing.T) {
+	email := IDNEmail("josé@example.com")
+	str := "用户@例子.广告"
+	validEmails := []string{
+		"user@example.com",
+		"josé@bücher.de",
+		"Pelé@example.com",
+		"δοκιμή@παράδειγμα.δοκιμή",
+		"我買@屋企.香港",
+		"二ノ宮@黒川.日本",
+		"медведь@с-балалайкой.рф",
+		"Name <ü@example.com>",
+	}
+	invalidEmails := []string{
+		"",
+		"example.com",
+		"@example.com",
+		"josé@",
+		"josé@bü cher.de",
+		"josé@-bücher.de",
+		"josé@bücher_de.com",
+		"a@b@example.com",
+	}
+
+	testStringFormat(t, &email, "idn-email", str, validEmails, invalidEmails)
+}
+
+func TestIDNEmail_Normalize(t *testing.T) {
+	assert.Equal(t, IDNEmail("josé@xn--bcher-kva.de"), IDNEmail("josé@bücher.de").Normalize())
+	assert.Equal(t, IDNEmail("user@example.com"), IDNEmail("user@example.com").Normalize())
+	assert.Equal(t, IDNEmail("user@example.com"), IDNEmail("user@Example.COM").Normalize())
+	assert.Equal(t, IDNEmail("not-an-email"), IDNEmail("not-an-email").Normalize())
+	assert.Equal(t, IDNEmail("user@bü cher.de"), IDNEmail("user@bü cher.de").Normalize())
+}
+
+func TestDeepCopyIDNEmail(t *testing.T) {
+	email := IDNEmail("josé@bücher.de")
+	in := &email
+
+	out := new(IDNEmail)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *IDNEmail
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func BenchmarkIsIDNHostname(b *testing.B) {
+	hostnames := []string{
+		"example.com",
+		"www.example-hyphenated.org",
+		"bücher.example.com",
+		"www.詹姆斯.org",
+		"www.élégigôö.org",
+		"a.b.c.d.e.f.g.dot",
+	}
+
+	b.Run("IsHostname - IDNA2003 (regexp)", benchmarkIs(hostnames, IsHostname))
+	b.Run("IsIDNHostname - IDNA2008 (idna lookup)", benchmarkIs(hostnames, IsIDNHostname))
+}
@@ -0,0 +1,68 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// ToIPv6 returns the IPv4-mapped IPv6 form of this address, e.g. "::ffff:192.168.1.1" for "192.168.1.1".
+//
+// An empty IPv6 is returned when this is not a valid IPv4 address.
+func (u IPv4) ToIPv6() IPv6 {
+	addr, err := netip.ParseAddr(string(u))
+	if err != nil || !addr.Unmap().Is4() {
+		return ""
+	}
+	return IPv6(netip.AddrFrom16(addr.As16()).String())
+}
+
+// IsIPv4Mapped returns true when this is an IPv4-mapped IPv6 address, e.g. "::ffff:192.168.1.1"
+func (u IPv6) IsIPv4Mapped() bool {
+	addr, err := netip.ParseAddr(string(u))
+	return err == nil && addr.Is4In6()
+}
+
+// IsIPv4Compatible returns true when this is an IPv4-compatible IPv6 address, e.g. "::192.168.1.1".
+//
+// This form is deprecated by RFC 4291. The unspecified ("::") and loopback ("::1") addresses are not IPv4-compatible.
+func (u IPv6) IsIPv4Compatible() bool {
+	addr, err := netip.ParseAddr(string(u))
+	if err != nil || !addr.Is6() || addr.Is4In6() || addr.IsUnspecified() || addr.IsLoopback() {
+		return false
+	}
+	b := addr.As16()
+	for _, v := range b[:12] {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ToIPv4 returns the IPv4 address mapped by this IPv4-mapped IPv6 address, e.g. "192.168.1.1" for "::ffff:192.168.1.1".
+//
+// An error is returned when this is not an IPv4-mapped IPv6 address.
+func (u IPv6) ToIPv4() (IPv4, error) {
+	addr, err := netip.ParseAddr(string(u))
+	if err != nil {
+		return "", err
+	}
+	if !addr.Is4In6() {
+		return "", fmt.Errorf("%q is not an IPv4-mapped IPv6 address", string(u))
+	}
+	return IPv4(addr.Unmap().WithZone("").String()), nil
+}
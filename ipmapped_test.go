@@ -0,0 +1,70 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPv4_ToIPv6(t *testing.T) {
+	mapped := IPv4("192.168.1.1").ToIPv6()
+	assert.Equal(t, IPv6("::ffff:192.168.1.1"), mapped)
+	assert.True(t, mapped.IsIPv4Mapped())
+	assert.False(t, mapped.IsIPv4Compatible())
+
+	back, err := mapped.ToIPv4()
+	require.NoError(t, err)
+	assert.Equal(t, IPv4("192.168.1.1"), back)
+
+	assert.Equal(t, IPv6("::ffff:0.0.0.0"), IPv4("0.0.0.0").ToIPv6())
+	assert.Equal(t, IPv6("::ffff:10.0.0.1"), IPv4("::ffff:10.0.0.1").ToIPv6())
+
+	for _, invalid := range []IPv4{"", "192.168.1", "2001:db8::1", "not an ip"} {
+		assert.Emptyf(t, invalid.ToIPv6(), "expected no IPv6 address for %q", invalid)
+	}
+}
+
+func TestIPv6_IPv4Forms(t *testing.T) {
+	for _, tc := range []struct {
+		addr       IPv6
+		mapped     bool
+		compatible bool
+		ipv4       IPv4
+	}{
+		{addr: "::ffff:192.168.1.1", mapped: true, ipv4: "192.168.1.1"},
+		{addr: "::FFFF:C0A8:0101", mapped: true, ipv4: "192.168.1.1"},
+		{addr: "::192.168.1.1", compatible: true},
+		{addr: "::c0a8:101", compatible: true},
+		{addr: "::"},
+		{addr: "::1"},
+		{addr: "2001:db8::1"},
+		{addr: "192.168.1.1"},
+		{addr: "not an ip"},
+	} {
+		assert.Equalf(t, tc.mapped, tc.addr.IsIPv4Mapped(), "unexpected IsIPv4Mapped for %s", tc.addr)
+		assert.Equalf(t, tc.compatible, tc.addr.IsIPv4Compatible(), "unexpected IsIPv4Compatible for %s", tc.addr)
+
+		ipv4, err := tc.addr.ToIPv4()
+		if !tc.mapped {
+			require.Errorf(t, err, "expected %s not to convert to IPv4", tc.addr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.ipv4, ipv4)
+	}
+}
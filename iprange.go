@@ -0,0 +1,107 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+)
+
+// maxIPv4RangeSize is the largest number of addresses returned by IPv4Range
+const maxIPv4RangeSize = 1 << 16
+
+// ErrIPv4RangeTooLarge is returned by IPv4Range when a range spans more than 65536 addresses
+var ErrIPv4RangeTooLarge = errors.New("IPv4 range is too large: at most 65536 addresses may be listed")
+
+// uint32 returns the numerical value of this address.
+//
+// IPv4-mapped IPv6 addresses (e.g. "::ffff:192.168.0.1") are accepted.
+func (u IPv4) uint32() (uint32, error) {
+	addr, err := netip.ParseAddr(string(u))
+	if err != nil {
+		return 0, err
+	}
+	addr = addr.Unmap()
+	if !addr.Is4() {
+		return 0, fmt.Errorf("%q is not an IPv4 address", string(u))
+	}
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+// ipv4FromUint32 returns the address with a given numerical value
+func ipv4FromUint32(n uint32) IPv4 {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], n)
+	return IPv4(netip.AddrFrom4(b).String())
+}
+
+// Increment returns the address n steps after this address, or before it when n is negative.
+//
+// E.g. "192.168.0.255" incremented by 1 is "192.168.1.0".
+// An error is returned when the result falls outside of the IPv4 address space.
+func (u IPv4) Increment(n int) (IPv4, error) {
+	v, err := u.uint32()
+	if err != nil {
+		return "", err
+	}
+	res := int64(v) + int64(n)
+	if res < 0 || res > math.MaxUint32 {
+		return "", fmt.Errorf("cannot increment %q by %d: out of the IPv4 address space", string(u), n)
+	}
+	return ipv4FromUint32(uint32(res)), nil
+}
+
+// Next returns the address following this address
+func (u IPv4) Next() (IPv4, error) {
+	return u.Increment(1)
+}
+
+// Prev returns the address preceding this address
+func (u IPv4) Prev() (IPv4, error) {
+	return u.Increment(-1)
+}
+
+// IPv4Range returns all the addresses from start to end, inclusive.
+//
+// At most 65536 addresses are returned: ErrIPv4RangeTooLarge is returned for larger ranges.
+func IPv4Range(start, end IPv4) ([]IPv4, error) {
+	first, err := start.uint32()
+	if err != nil {
+		return nil, err
+	}
+	last, err := end.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if first > last {
+		return nil, fmt.Errorf("invalid IPv4 range: %q is after %q", string(start), string(end))
+	}
+	if uint64(last-first) >= maxIPv4RangeSize {
+		return nil, ErrIPv4RangeTooLarge
+	}
+
+	ips := make([]IPv4, 0, last-first+1)
+	for n := first; ; n++ {
+		ips = append(ips, ipv4FromUint32(n))
+		if n == last {
+			break
+		}
+	}
+	return ips, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPv4_Increment(t *testing.T) {
+	for _, tc := range []struct {
+		ip       IPv4
+		n        int
+		expected IPv4
+	}{
+		{"192.168.0.1", 1, "192.168.0.2"},
+		{"192.168.0.255", 1, "192.168.1.0"},
+		{"192.168.1.0", -1, "192.168.0.255"},
+		{"10.0.0.0", 65536, "10.1.0.0"},
+		{"10.0.0.0", 0, "10.0.0.0"},
+		{"::ffff:10.0.0.1", 1, "10.0.0.2"},
+		{"0.0.0.0", 1<<31 - 1, "127.255.255.255"},
+		{"127.255.255.255", -(1<<31 - 1), "0.0.0.0"},
+	} {
+		ip, err := tc.ip.Increment(tc.n)
+		require.NoError(t, err)
+		assert.Equalf(t, tc.expected, ip, "unexpected increment of %s by %d", tc.ip, tc.n)
+	}
+
+	_, err := IPv4("255.255.255.255").Increment(1)
+	require.Error(t, err)
+	_, err = IPv4("0.0.0.0").Increment(-1)
+	require.Error(t, err)
+	_, err = IPv4("::1").Increment(1)
+	require.Error(t, err)
+	_, err = IPv4("nope").Increment(1)
+	require.Error(t, err)
+
+	next, err := IPv4("10.0.0.255").Next()
+	require.NoError(t, err)
+	assert.Equal(t, IPv4("10.0.1.0"), next)
+	prev, err := next.Prev()
+	require.NoError(t, err)
+	assert.Equal(t, IPv4("10.0.0.255"), prev)
+	_, err = IPv4("0.0.0.0").Prev()
+	require.Error(t, err)
+	_, err = IPv4("255.255.255.255").Next()
+	require.Error(t, err)
+}
+
+func TestIPv4Range(t *testing.T) {
+	ips, err := IPv4Range("192.168.0.254", "192.168.1.1")
+	require.NoError(t, err)
+	assert.Equal(t, []IPv4{"192.168.0.254", "192.168.0.255", "192.168.1.0", "192.168.1.1"}, ips)
+
+	ips, err = IPv4Range("10.0.0.1", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, []IPv4{"10.0.0.1"}, ips)
+
+	ips, err = IPv4Range("255.255.255.254", "255.255.255.255")
+	require.NoError(t, err)
+	assert.Equal(t, []IPv4{"255.255.255.254", "255.255.255.255"}, ips)
+
+	ips, err = IPv4Range("10.0.0.0", "10.0.255.255")
+	require.NoError(t, err)
+	assert.Len(t, ips, 65536)
+
+	_, err = IPv4Range("10.0.0.0", "10.1.0.0")
+	require.ErrorIs(t, err, ErrIPv4RangeTooLarge)
+	_, err = IPv4Range("0.0.0.0", "255.255.255.255")
+	require.ErrorIs(t, err, ErrIPv4RangeTooLarge)
+
+	_, err = IPv4Range("10.0.0.2", "10.0.0.1")
+	require.Error(t, err)
+	_, err = IPv4Range("nope", "10.0.0.1")
+	require.Error(t, err)
+	_, err = IPv4Range("10.0.0.1", "::1")
+	require.Error(t, err)
+}
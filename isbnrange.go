@@ -0,0 +1,199 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/asaskevich/govalidator"
+)
+
+//go:generate go run hack/isbnranges/main.go -o isbnranges/RangeMessage.xml
+
+// isbnRangeDigits is the number of digits compared against the ranges of a range message
+const isbnRangeDigits = 7
+
+var (
+	isbnRangesMu sync.RWMutex
+	isbnRanges   isbnRangeTable
+
+	errISBNRangesNotLoaded = errors.New("ISBN range data is not available: build with the isbnranges tag or call LoadISBNRangeMessage")
+)
+
+// isbnRule tells the length of the next ISBN element, for a range of the 7 digits following a prefix.
+//
+// A zero length indicates a range which is not allocated.
+type isbnRule struct {
+	lo, hi int
+	length int
+}
+
+// isbnRangeTable is a prefix tree of ISBN ranges.
+//
+// Keys are prefixes without separators, such as "978" for an EAN.UCC prefix or "9780" for a registration group.
+type isbnRangeTable map[string][]isbnRule
+
+// lookup returns the length of the ISBN element following prefix in digits
+func (t isbnRangeTable) lookup(prefix, digits string) (int, error) {
+	rules, ok := t[prefix]
+	if !ok {
+		return 0, fmt.Errorf("no ISBN range defined for prefix %q", prefix)
+	}
+
+	next := digits[len(prefix):]
+	if len(next) > isbnRangeDigits {
+		next = next[:isbnRangeDigits]
+	}
+	value, err := strconv.Atoi(next + strings.Repeat("0", isbnRangeDigits-len(next)))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, rule := range rules {
+		if value >= rule.lo && value <= rule.hi {
+			if rule.length == 0 {
+				break
+			}
+			return rule.length, nil
+		}
+	}
+	return 0, fmt.Errorf("ISBN range for prefix %q is not allocated: %q", prefix, digits)
+}
+
+// split returns the lengths of the registration group and registrant elements of a 13 digits ISBN
+func (t isbnRangeTable) split(digits string) (int, int, error) {
+	if t == nil {
+		return 0, 0, errISBNRangesNotLoaded
+	}
+
+	group, err := t.lookup(digits[:3], digits)
+	if err != nil {
+		return 0, 0, err
+	}
+	publisher, err := t.lookup(digits[:3+group], digits)
+	if err != nil {
+		return 0, 0, err
+	}
+	return group, publisher, nil
+}
+
+// isbnRangeMessage is the XML range message published by the International ISBN Agency.
+//
+// See https://www.isbn-international.org/range_file_generation
+type isbnRangeMessage struct {
+	XMLName  xml.Name           `xml:"ISBNRangeMessage"`
+	Prefixes []isbnRangeElement `xml:"EAN.UCCPrefixes>EAN.UCC"`
+	Groups   []isbnRangeElement `xml:"RegistrationGroups>Group"`
+}
+
+type isbnRangeElement struct {
+	Prefix string `xml:"Prefix"`
+	Rules  []struct {
+		Range  string `xml:"Range"`
+		Length int    `xml:"Length"`
+	} `xml:"Rules>Rule"`
+}
+
+// LoadISBNRangeMessage loads the ISBN ranges used by ISBN.RegistrationGroup and ISBN.PublisherPrefix.
+//
+// The reader must provide an XML range message, as published by the International ISBN Agency at
+// https://www.isbn-international.org/range_file_generation. Loaded ranges replace any previous ones.
+//
+// Binaries built with the isbnranges build tag load an embedded range message on init.
+func LoadISBNRangeMessage(r io.Reader) error {
+	var msg isbnRangeMessage
+	if err := xml.NewDecoder(r).Decode(&msg); err != nil {
+		return fmt.Errorf("invalid ISBN range message: %w", err)
+	}
+
+	table := make(isbnRangeTable, len(msg.Prefixes)+len(msg.Groups))
+	for _, element := range append(msg.Prefixes, msg.Groups...) {
+		prefix := isbnSeparators.Replace(element.Prefix)
+		rules := make([]isbnRule, 0, len(element.Rules))
+		for _, rule := range element.Rules {
+			lo, hi, found := strings.Cut(rule.Range, "-")
+			if !found {
+				return fmt.Errorf("invalid ISBN range %q for prefix %q", rule.Range, element.Prefix)
+			}
+			loValue, err := strconv.Atoi(lo)
+			if err != nil {
+				return fmt.Errorf("invalid ISBN range %q for prefix %q: %w", rule.Range, element.Prefix, err)
+			}
+			hiValue, err := strconv.Atoi(hi)
+			if err != nil {
+				return fmt.Errorf("invalid ISBN range %q for prefix %q: %w", rule.Range, element.Prefix, err)
+			}
+			rules = append(rules, isbnRule{lo: loValue, hi: hiValue, length: rule.Length})
+		}
+		table[prefix] = rules
+	}
+
+	isbnRangesMu.Lock()
+	isbnRanges = table
+	isbnRangesMu.Unlock()
+
+	return nil
+}
+
+// isbnElements returns the 13 digits form of this ISBN with the lengths of its registration group
+// and registrant elements
+func (u ISBN) isbnElements() (string, int, int, error) {
+	digits := string(u.Normalize())
+	if len(digits) != 13 || !govalidator.IsISBN13(digits) {
+		return "", 0, 0, fmt.Errorf("invalid ISBN: %q", string(u))
+	}
+
+	isbnRangesMu.RLock()
+	table := isbnRanges
+	isbnRangesMu.RUnlock()
+
+	group, publisher, err := table.split(digits)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	return digits, group, publisher, nil
+}
+
+// RegistrationGroup returns the registration group (language or country group) of this ISBN,
+// prefixed by its EAN.UCC prefix, e.g. "978-0".
+//
+// ISBN-10 are converted to their ISBN-13 equivalent. The lookup requires ISBN range data:
+// see LoadISBNRangeMessage.
+func (u ISBN) RegistrationGroup() (string, error) {
+	digits, group, _, err := u.isbnElements()
+	if err != nil {
+		return "", err
+	}
+	return digits[:3] + "-" + digits[3:3+group], nil
+}
+
+// PublisherPrefix returns the publisher prefix of this ISBN, made of its EAN.UCC prefix,
+// registration group and registrant elements, e.g. "978-0-306".
+//
+// ISBN-10 are converted to their ISBN-13 equivalent. The lookup requires ISBN range data:
+// see LoadISBNRangeMessage.
+func (u ISBN) PublisherPrefix() (string, error) {
+	digits, group, publisher, err := u.isbnElements()
+	if err != nil {
+		return "", err
+	}
+	return digits[:3] + "-" + digits[3:3+group] + "-" + digits[3+group:3+group+publisher], nil
+}
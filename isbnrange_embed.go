@@ -0,0 +1,33 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build isbnranges
+
+package strfmt
+
+import (
+	"bytes"
+	_ "embed"
+)
+
+// isbnRangeData is the range message loaded on init when building with the isbnranges tag
+//
+//go:embed isbnranges/RangeMessage.xml
+var isbnRangeData []byte
+
+func init() {
+	if err := LoadISBNRangeMessage(bytes.NewReader(isbnRangeData)); err != nil {
+		panic(err)
+	}
+}
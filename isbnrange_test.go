@@ -0,0 +1,113 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadTestISBNRanges(t *testing.T) {
+	t.Helper()
+
+	isbnRangesMu.RLock()
+	previous := isbnRanges
+	isbnRangesMu.RUnlock()
+	t.Cleanup(func() {
+		isbnRangesMu.Lock()
+		isbnRanges = previous
+		isbnRangesMu.Unlock()
+	})
+
+	f, err := os.Open("isbnranges/RangeMessage.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.NoError(t, LoadISBNRangeMessage(f))
+}
+
+func TestISBN_PublisherPrefix(t *testing.T) {
+	loadTestISBNRanges(t)
+
+	for _, tc := range []struct {
+		isbn      ISBN
+		group     string
+		publisher string
+	}{
+		{"0-306-40615-2", "978-0", "978-0-306"},
+		{"978-0-306-40615-7", "978-0", "978-0-306"},
+		{"0198534531", "978-0", "978-0-19"},
+		{"978-0-596-52068-7", "978-0", "978-0-596"},
+		{"978-1-4028-9462-6", "978-1", "978-1-4028"},
+		{"1-56619-909-3", "978-1", "978-1-56619"},
+		{"978-3-16-148410-0", "978-3", "978-3-16"},
+		{"3-499-13599-X", "978-3", "978-3-499"},
+		{"978-3-8274-1092-4", "978-3", "978-3-8274"},
+		{"979-10-90636-07-1", "979-10", "979-10-90636"},
+		{"979-10-354-0001-9", "979-10", "979-10-354"},
+	} {
+		group, err := tc.isbn.RegistrationGroup()
+		require.NoErrorf(t, err, "unexpected error for %q", tc.isbn)
+		assert.Equalf(t, tc.group, group, "unexpected registration group for %q", tc.isbn)
+
+		publisher, err := tc.isbn.PublisherPrefix()
+		require.NoErrorf(t, err, "unexpected error for %q", tc.isbn)
+		assert.Equalf(t, tc.publisher, publisher, "unexpected publisher prefix for %q", tc.isbn)
+	}
+
+	for _, invalid := range []ISBN{"", "0-306-40615-3", "978-0-306-40615-8", "not an ISBN"} {
+		_, err := invalid.RegistrationGroup()
+		require.Errorf(t, err, "expected an error for %q", invalid)
+		_, err = invalid.PublisherPrefix()
+		require.Errorf(t, err, "expected an error for %q", invalid)
+	}
+
+	// registration group not listed in the test range message
+	_, err := ISBN("979-11-5629-099-5").PublisherPrefix()
+	require.Error(t, err)
+}
+
+func TestISBN_PublisherPrefixWithoutRanges(t *testing.T) {
+	isbnRangesMu.Lock()
+	previous := isbnRanges
+	isbnRanges = nil
+	isbnRangesMu.Unlock()
+	t.Cleanup(func() {
+		isbnRangesMu.Lock()
+		isbnRanges = previous
+		isbnRangesMu.Unlock()
+	})
+
+	_, err := ISBN("978-0-306-40615-7").RegistrationGroup()
+	require.ErrorIs(t, err, errISBNRangesNotLoaded)
+	_, err = ISBN("978-0-306-40615-7").PublisherPrefix()
+	require.ErrorIs(t, err, errISBNRangesNotLoaded)
+}
+
+func TestLoadISBNRangeMessage_Errors(t *testing.T) {
+	loadTestISBNRanges(t)
+
+	require.Error(t, LoadISBNRangeMessage(strings.NewReader("not xml")))
+	require.Error(t, LoadISBNRangeMessage(strings.NewReader(
+		`<ISBNRangeMessage><RegistrationGroups><Group><Prefix>978-0</Prefix><Rules><Rule><Range>0000000</Range><Length>2</Length></Rule></Rules></Group></RegistrationGroups></ISBNRangeMessage>`,
+	)))
+	require.Error(t, LoadISBNRangeMessage(strings.NewReader(
+		`<ISBNRangeMessage><RegistrationGroups><Group><Prefix>978-0</Prefix><Rules><Rule><Range>a-b</Range><Length>2</Length></Rule></Rules></Group></RegistrationGroups></ISBNRangeMessage>`,
+	)))
+}
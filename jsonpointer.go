@@ -0,0 +1,169 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	jp := JSONPointer("")
+	// register this format in the default registry
+	addBuiltin("json-pointer", &jp, IsJSONPointer)
+}
+
+var (
+	jsonPointerDecoder = strings.NewReplacer("~1", "/", "~0", "~")
+	jsonPointerEncoder = strings.NewReplacer("~", "~0", "/", "~1")
+)
+
+// IsJSONPointer returns true when the string is a valid JSON pointer, as specified by RFC 6901.
+//
+// The empty string is a valid pointer to the whole document.
+// Any other pointer must start with '/', and '~' may only appear in the escape sequences "~0" and "~1".
+func IsJSONPointer(str string) bool {
+	if str == "" {
+		return true
+	}
+	if str[0] != '/' {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if str[i] != '~' {
+			continue
+		}
+		if i+1 >= len(str) || (str[i+1] != '0' && str[i+1] != '1') {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// JSONPointer represents a JSON pointer, as specified by RFC 6901
+//
+// swagger:strfmt json-pointer
+type JSONPointer string
+
+// Tokens returns the decoded reference tokens of this pointer.
+//
+// The root pointer ("") has no tokens.
+func (p JSONPointer) Tokens() []string {
+	if p == "" {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(string(p), "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = jsonPointerDecoder.Replace(token)
+	}
+	return tokens
+}
+
+// Append returns a new pointer with the (unescaped) token appended to this pointer
+func (p JSONPointer) Append(token string) JSONPointer {
+	return p + "/" + JSONPointer(jsonPointerEncoder.Replace(token))
+}
+
+// MarshalText turns this instance into text
+func (p JSONPointer) MarshalText() ([]byte, error) {
+	return []byte(string(p)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (p *JSONPointer) UnmarshalText(data []byte) error { // validation is performed later on
+	*p = JSONPointer(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (p *JSONPointer) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*p = JSONPointer(string(v))
+	case string:
+		*p = JSONPointer(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.JSONPointer from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (p JSONPointer) Value() (driver.Value, error) {
+	return driver.Value(string(p)), nil
+}
+
+func (p JSONPointer) String() string {
+	return string(p)
+}
+
+// MarshalJSON returns the JSONPointer as JSON
+func (p JSONPointer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(p))
+}
+
+// UnmarshalJSON sets the JSONPointer from JSON
+func (p *JSONPointer) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var ustr string
+	if err := json.Unmarshal(data, &ustr); err != nil {
+		return err
+	}
+	*p = JSONPointer(ustr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (p JSONPointer) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": p.String()})
+}
+
+// UnmarshalBSON document into this value
+func (p *JSONPointer) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*p = JSONPointer(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as JSONPointer")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (p *JSONPointer) DeepCopyInto(out *JSONPointer) {
+	*out = *p
+}
+
+// DeepCopy copies the receiver into a new JSONPointer.
+func (p *JSONPointer) DeepCopy() *JSONPointer {
+	if p == nil {
+		return nil
+	}
+	out := new(JSONPointer)
+	p.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,81 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatJSONPointer(t *testing.T) {
+	pointer := JSONPointer("/foo")
+	str := "/foo/bar~1baz~0qux"
+	validPointers := []string{
+		"",
+		"/",
+		"/foo/0",
+		"/a~1b",
+		"/m~0n",
+		"/ ",
+		"/c%d",
+		"//",
+		"/~01",
+	}
+	invalidPointers := []string{
+		"foo",
+		"#/foo",
+		"/foo~",
+		"/foo~2bar",
+		"/~",
+		"~0",
+	}
+
+	testStringFormat(t, &pointer, "json-pointer", str, validPointers, invalidPointers)
+}
+
+func TestJSONPointer_Tokens(t *testing.T) {
+	assert.Equal(t, []string{"foo", "bar/baz~qux"}, JSONPointer("/foo/bar~1baz~0qux").Tokens())
+	assert.Equal(t, []string{"~1"}, JSONPointer("/~01").Tokens())
+	assert.Equal(t, []string{""}, JSONPointer("/").Tokens())
+	assert.Equal(t, []string{"", ""}, JSONPointer("//").Tokens())
+	assert.Nil(t, JSONPointer("").Tokens())
+}
+
+func TestJSONPointer_Append(t *testing.T) {
+	assert.Equal(t, JSONPointer("/foo"), JSONPointer("").Append("foo"))
+	assert.Equal(t, JSONPointer("/foo/bar~1baz~0qux"), JSONPointer("/foo").Append("bar/baz~qux"))
+	assert.Equal(t, JSONPointer("/foo/"), JSONPointer("/foo").Append(""))
+
+	pointer := JSONPointer("").Append("a/b").Append("~1")
+	assert.True(t, IsJSONPointer(pointer.String()))
+	assert.Equal(t, []string{"a/b", "~1"}, pointer.Tokens())
+}
+
+func TestDeepCopyJSONPointer(t *testing.T) {
+	pointer := JSONPointer("/foo/bar")
+	in := &pointer
+
+	out := new(JSONPointer)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *JSONPointer
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
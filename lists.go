@@ -0,0 +1,301 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	// register formats in the default registry:
+	//   - email-list
+	//   - hostname-list
+	el := EmailList(nil)
+	addBuiltin("email-list", &el, IsEmailList)
+
+	hl := HostnameList(nil)
+	addBuiltin("hostname-list", &hl, IsHostnameList)
+}
+
+// listSeparator joins the elements of EmailList and HostnameList when marshaled
+const listSeparator = ", "
+
+// splitList splits a comma-separated list, trims its elements and checks them with the validator.
+//
+// An empty or blank string is an empty list.
+func splitList(str, name string, validator Validator) ([]string, error) {
+	if strings.TrimSpace(str) == "" {
+		return nil, nil
+	}
+	items := strings.Split(str, ",")
+	for i, item := range items {
+		item = strings.TrimSpace(item)
+		if !validator(item) {
+			return nil, fmt.Errorf("invalid %s at position %d of the list: %q", name, i, item)
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+// IsEmailList returns true when the string is a comma-separated list of email addresses
+func IsEmailList(str string) bool {
+	_, err := splitList(str, "email", IsEmail)
+	return err == nil
+}
+
+// IsHostnameList returns true when the string is a comma-separated list of hostnames
+func IsHostnameList(str string) bool {
+	_, err := splitList(str, "hostname", IsHostname)
+	return err == nil
+}
+
+// EmailList represents a comma-separated list of email addresses (e.g. "john@example.com, jane@example.com")
+//
+// Unlike most formats, the elements of the list are validated when unmarshaled.
+// Note that display names containing commas are not supported.
+//
+// swagger:strfmt email-list
+type EmailList []Email
+
+// Deduplicate returns the list without duplicate addresses, keeping the first occurrence of each address.
+//
+// Addresses are compared case-insensitively.
+func (l EmailList) Deduplicate() EmailList {
+	seen := make(map[string]struct{}, len(l))
+	var out EmailList
+	for _, e := range l {
+		key := strings.ToLower(string(e))
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, e)
+	}
+	return out
+}
+
+// FilterDomain returns the addresses of the list which belong to the specified domain, compared case-insensitively
+func (l EmailList) FilterDomain(domain string) EmailList {
+	domain = normalizeEmailDomain(domain)
+	var out EmailList
+	for _, e := range l {
+		if e.Domain() == domain {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// MarshalText turns this instance into text
+func (l EmailList) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text, and validates the elements of the list
+func (l *EmailList) UnmarshalText(data []byte) error {
+	items, err := splitList(string(data), "email", IsEmail)
+	if err != nil {
+		return err
+	}
+	list := make(EmailList, 0, len(items))
+	for _, item := range items {
+		list = append(list, Email(item))
+	}
+	*l = list
+	return nil
+}
+
+// Scan read a value from a database driver
+func (l *EmailList) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return l.UnmarshalText(v)
+	case string:
+		return l.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.EmailList from: %#v", v)
+	}
+}
+
+// Value converts a value to a database driver value
+func (l EmailList) Value() (driver.Value, error) {
+	return driver.Value(l.String()), nil
+}
+
+func (l EmailList) String() string {
+	items := make([]string, 0, len(l))
+	for _, e := range l {
+		items = append(items, string(e))
+	}
+	return strings.Join(items, listSeparator)
+}
+
+// MarshalJSON returns the EmailList as a JSON string
+func (l EmailList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON sets the EmailList from a JSON string
+func (l *EmailList) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var lstr string
+	if err := json.Unmarshal(data, &lstr); err != nil {
+		return err
+	}
+	return l.UnmarshalText([]byte(lstr))
+}
+
+// MarshalBSON document from this value
+func (l EmailList) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": l.String()})
+}
+
+// UnmarshalBSON document into this value
+func (l *EmailList) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		return l.UnmarshalText([]byte(ud))
+	}
+	return errors.New("couldn't unmarshal bson bytes as EmailList")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (l *EmailList) DeepCopyInto(out *EmailList) {
+	*out = append(EmailList(nil), *l...)
+}
+
+// DeepCopy copies the receiver into a new EmailList.
+func (l *EmailList) DeepCopy() *EmailList {
+	if l == nil {
+		return nil
+	}
+	out := new(EmailList)
+	l.DeepCopyInto(out)
+	return out
+}
+
+// HostnameList represents a comma-separated list of hostnames (e.g. "example.com, www.example.com")
+//
+// Unlike most formats, the elements of the list are validated when unmarshaled.
+//
+// swagger:strfmt hostname-list
+type HostnameList []Hostname
+
+// MarshalText turns this instance into text
+func (l HostnameList) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text, and validates the elements of the list
+func (l *HostnameList) UnmarshalText(data []byte) error {
+	items, err := splitList(string(data), "hostname", IsHostname)
+	if err != nil {
+		return err
+	}
+	list := make(HostnameList, 0, len(items))
+	for _, item := range items {
+		list = append(list, Hostname(item))
+	}
+	*l = list
+	return nil
+}
+
+// Scan read a value from a database driver
+func (l *HostnameList) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return l.UnmarshalText(v)
+	case string:
+		return l.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.HostnameList from: %#v", v)
+	}
+}
+
+// Value converts a value to a database driver value
+func (l HostnameList) Value() (driver.Value, error) {
+	return driver.Value(l.String()), nil
+}
+
+func (l HostnameList) String() string {
+	items := make([]string, 0, len(l))
+	for _, h := range l {
+		items = append(items, string(h))
+	}
+	return strings.Join(items, listSeparator)
+}
+
+// MarshalJSON returns the HostnameList as a JSON string
+func (l HostnameList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON sets the HostnameList from a JSON string
+func (l *HostnameList) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var lstr string
+	if err := json.Unmarshal(data, &lstr); err != nil {
+		return err
+	}
+	return l.UnmarshalText([]byte(lstr))
+}
+
+// MarshalBSON document from this value
+func (l HostnameList) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": l.String()})
+}
+
+// UnmarshalBSON document into this value
+func (l *HostnameList) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		return l.UnmarshalText([]byte(ud))
+	}
+	return errors.New("couldn't unmarshal bson bytes as HostnameList")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (l *HostnameList) DeepCopyInto(out *HostnameList) {
+	*out = append(HostnameList(nil), *l...)
+}
+
+// DeepCopy copies the receiver into a new HostnameList.
+func (l *HostnameList) DeepCopy() *HostnameList {
+	if l == nil {
+		return nil
+	}
+	out := new(HostnameList)
+	l.DeepCopyInto(out)
+	return out
+}
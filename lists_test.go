@@ -0,0 +1,160 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	_ testableFormat = &EmailList{}
+	_ testableFormat = &HostnameList{}
+)
+
+func TestFormatEmailList(t *testing.T) {
+	const str = "john@example.com, Jane Doe <jane@example.com>"
+	expected := EmailList{"john@example.com", "Jane Doe <jane@example.com>"}
+
+	var l EmailList
+	require.NoError(t, l.UnmarshalText([]byte(" john@example.com,Jane Doe <jane@example.com> ")))
+	assert.Equal(t, expected, l)
+	b, err := l.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, str, string(b))
+	assert.Equal(t, str, l.String())
+
+	b, err = l.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `"john@example.com, Jane Doe \u003cjane@example.com\u003e"`, string(b))
+	l = nil
+	require.NoError(t, l.UnmarshalJSON(b))
+	assert.Equal(t, expected, l)
+	require.NoError(t, l.UnmarshalJSON([]byte(jsonNull)))
+	assert.Equal(t, expected, l)
+	require.Error(t, l.UnmarshalJSON([]byte(`["john@example.com"]`)))
+
+	bsonData, err := bson.Marshal(l)
+	require.NoError(t, err)
+	var fromBSON EmailList
+	require.NoError(t, bson.Unmarshal(bsonData, &fromBSON))
+	assert.Equal(t, expected, fromBSON)
+
+	var scanned EmailList
+	require.NoError(t, scanned.Scan(str))
+	assert.Equal(t, expected, scanned)
+	require.NoError(t, scanned.Scan([]byte(str)))
+	assert.Equal(t, expected, scanned)
+	require.Error(t, scanned.Scan(123))
+	v, err := scanned.Value()
+	require.NoError(t, err)
+	assert.Equal(t, str, v)
+
+	require.NoError(t, l.UnmarshalText([]byte("  ")))
+	assert.Empty(t, l)
+	require.Error(t, l.UnmarshalText([]byte("john@example.com, not an email")))
+	require.Error(t, l.UnmarshalText([]byte("john@example.com,,jane@example.com")))
+
+	for _, valid := range []string{str, "", "john@example.com"} {
+		testValid(t, "email-list", valid)
+	}
+	for _, invalid := range []string{"john@example.com;jane@example.com", "john@example.com,", "example.com"} {
+		testInvalid(t, "email-list", invalid)
+	}
+}
+
+func TestEmailList_Operations(t *testing.T) {
+	l := EmailList{"john@example.com", "jane@other.org", "JOHN@example.com", "jim@Example.COM", "jane@other.org"}
+
+	assert.Equal(t, EmailList{"john@example.com", "jane@other.org", "jim@Example.COM"}, l.Deduplicate())
+	assert.Equal(t, EmailList{"john@example.com", "JOHN@example.com", "jim@Example.COM"}, l.FilterDomain("EXAMPLE.com"))
+	assert.Empty(t, l.FilterDomain("example.net"))
+	assert.Empty(t, EmailList(nil).Deduplicate())
+}
+
+func TestFormatHostnameList(t *testing.T) {
+	const str = "example.com, www.example.com"
+	expected := HostnameList{"example.com", "www.example.com"}
+
+	var l HostnameList
+	require.NoError(t, l.UnmarshalText([]byte("example.com,   www.example.com")))
+	assert.Equal(t, expected, l)
+	b, err := l.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(b))
+	l = nil
+	require.NoError(t, l.UnmarshalJSON(b))
+	assert.Equal(t, expected, l)
+
+	bsonData, err := bson.Marshal(l)
+	require.NoError(t, err)
+	var fromBSON HostnameList
+	require.NoError(t, bson.Unmarshal(bsonData, &fromBSON))
+	assert.Equal(t, expected, fromBSON)
+
+	var scanned HostnameList
+	require.NoError(t, scanned.Scan([]byte(str)))
+	assert.Equal(t, expected, scanned)
+	require.Error(t, scanned.Scan(123))
+	v, err := scanned.Value()
+	require.NoError(t, err)
+	assert.Equal(t, str, v)
+
+	require.Error(t, l.UnmarshalText([]byte("example.com, -invalid-.com")))
+
+	for _, valid := range []string{str, "", "localhost"} {
+		testValid(t, "hostname-list", valid)
+	}
+	for _, invalid := range []string{"example.com;www.example.com", "example.com,,www.example.com", "a b"} {
+		testInvalid(t, "hostname-list", invalid)
+	}
+}
+
+func TestDeepCopyEmailList(t *testing.T) {
+	l := EmailList{"john@example.com"}
+	in := &l
+
+	out := new(EmailList)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+	(*out)[0] = "jane@example.com"
+	assert.Equal(t, Email("john@example.com"), l[0])
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *EmailList
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestDeepCopyHostnameList(t *testing.T) {
+	l := HostnameList{"example.com"}
+	in := &l
+
+	out := new(HostnameList)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *HostnameList
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
@@ -0,0 +1,135 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	eui48Size = 6
+	eui64Size = 8
+)
+
+// IsMAC returns true when the string is a valid MAC address (MAC-48, EUI-48, EUI-64 or 20-octet
+// IP over InfiniBand link-layer address), in any of the following notations:
+//
+//	00:00:5e:00:53:01 (colon-separated)
+//	00-00-5e-00-53-01 (hyphen-separated)
+//	0000.5e00.5301    (dot-separated groups of 4 hex digits)
+func IsMAC(str string) bool {
+	_, err := net.ParseMAC(str)
+	return err == nil
+}
+
+// HardwareAddr parses this MAC address
+func (u MAC) HardwareAddr() (net.HardwareAddr, error) {
+	return net.ParseMAC(string(u))
+}
+
+// Format returns this MAC address in the notation using sep as separator, with lower case hex digits:
+//
+//	':' 00:00:5e:00:53:01 (Linux-style)
+//	'-' 00-00-5e-00-53-01 (Windows-style)
+//	'.' 0000.5e00.5301    (Cisco-style)
+func (u MAC) Format(sep byte) (string, error) {
+	addr, err := u.HardwareAddr()
+	if err != nil {
+		return "", err
+	}
+	return formatMAC(addr, sep)
+}
+
+func formatMAC(addr net.HardwareAddr, sep byte) (string, error) {
+	var group int
+	switch sep {
+	case ':', '-':
+		group = 1
+	case '.':
+		group = 2
+	default:
+		return "", fmt.Errorf("unsupported MAC address separator %q: must be one of ':', '-' or '.'", sep)
+	}
+
+	var b strings.Builder
+	b.Grow(len(addr)*3 - 1)
+	for i := 0; i < len(addr); i += group {
+		if i > 0 {
+			b.WriteByte(sep)
+		}
+		b.WriteString(hex.EncodeToString(addr[i : i+group]))
+	}
+	return b.String(), nil
+}
+
+// Normalize returns this MAC address in the colon-separated notation, with lower case hex digits
+func (u MAC) Normalize() (MAC, error) {
+	str, err := u.Format(':')
+	if err != nil {
+		return "", err
+	}
+	return MAC(str), nil
+}
+
+// IsEUI64 returns true when this is a valid 64 bit (8 octets) MAC address
+func (u MAC) IsEUI64() bool {
+	addr, err := u.HardwareAddr()
+	return err == nil && len(addr) == eui64Size
+}
+
+// ToEUI48 returns this MAC address as a colon-separated 48 bit address.
+//
+// An EUI-64 address may only be converted when it was derived from an EUI-48 address,
+// i.e. when its middle octets are FF:FE.
+func (u MAC) ToEUI48() (string, error) {
+	addr, err := u.HardwareAddr()
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case len(addr) == eui48Size:
+	case len(addr) == eui64Size && addr[3] == 0xff && addr[4] == 0xfe:
+		addr = append(addr[:3:3], addr[5:]...)
+	default:
+		return "", fmt.Errorf("cannot convert MAC address %q to EUI-48", string(u))
+	}
+	return formatMAC(addr, ':')
+}
+
+// ToEUI64 returns this MAC address as a colon-separated 64 bit address.
+//
+// A 48 bit address is converted to its EUI-64 form by inserting FF:FE between its third and fourth octets.
+func (u MAC) ToEUI64() (string, error) {
+	addr, err := u.HardwareAddr()
+	if err != nil {
+		return "", err
+	}
+
+	switch len(addr) {
+	case eui64Size:
+	case eui48Size:
+		eui64 := make(net.HardwareAddr, 0, eui64Size)
+		eui64 = append(eui64, addr[:3]...)
+		eui64 = append(eui64, 0xff, 0xfe)
+		addr = append(eui64, addr[3:]...)
+	default:
+		return "", fmt.Errorf("cannot convert MAC address %q to EUI-64", string(u))
+	}
+	return formatMAC(addr, ':')
+}
@@ -0,0 +1,118 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsMAC(t *testing.T) {
+	for _, valid := range []string{
+		"00:00:5e:00:53:01",
+		"00-00-5E-00-53-01",
+		"0000.5e00.5301",
+		"02:00:5e:10:00:00:00:01",
+		"0200.5e10.0000.0001",
+		"00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01",
+	} {
+		assert.Truef(t, IsMAC(valid), "expected %s to be a valid MAC address", valid)
+	}
+
+	for _, invalid := range []string{
+		"",
+		"01:02:03:04:05",
+		"00:00:5e:00:53:0g",
+		"00:00-5e:00:53:01",
+		"0000.5e00.53",
+	} {
+		assert.Falsef(t, IsMAC(invalid), "expected %s not to be a valid MAC address", invalid)
+	}
+}
+
+func TestMAC_Format(t *testing.T) {
+	for _, mac := range []MAC{"00:00:5E:00:53:01", "00-00-5e-00-53-01", "0000.5E00.5301"} {
+		colon, err := mac.Format(':')
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:01", colon)
+
+		hyphen, err := mac.Format('-')
+		require.NoError(t, err)
+		assert.Equal(t, "00-00-5e-00-53-01", hyphen)
+
+		dot, err := mac.Format('.')
+		require.NoError(t, err)
+		assert.Equal(t, "0000.5e00.5301", dot)
+
+		normalized, err := mac.Normalize()
+		require.NoError(t, err)
+		assert.Equal(t, MAC("00:00:5e:00:53:01"), normalized)
+	}
+
+	dot, err := MAC("02:00:5e:10:00:00:00:01").Format('.')
+	require.NoError(t, err)
+	assert.Equal(t, "0200.5e10.0000.0001", dot)
+
+	_, err = MAC("00:00:5e:00:53:01").Format('/')
+	require.Error(t, err)
+
+	_, err = MAC("01:02:03:04:05").Format(':')
+	require.Error(t, err)
+
+	_, err = MAC("01:02:03:04:05").Normalize()
+	require.Error(t, err)
+}
+
+func TestMAC_EUI(t *testing.T) {
+	eui48 := MAC("00-00-5E-00-53-01")
+	assert.False(t, eui48.IsEUI64())
+
+	eui64, err := eui48.ToEUI64()
+	require.NoError(t, err)
+	assert.Equal(t, "00:00:5e:ff:fe:00:53:01", eui64)
+	assert.True(t, MAC(eui64).IsEUI64())
+
+	back, err := MAC(eui64).ToEUI48()
+	require.NoError(t, err)
+	assert.Equal(t, "00:00:5e:00:53:01", back)
+
+	same, err := eui48.ToEUI48()
+	require.NoError(t, err)
+	assert.Equal(t, "00:00:5e:00:53:01", same)
+
+	same, err = MAC("0200.5e10.0000.0001").ToEUI64()
+	require.NoError(t, err)
+	assert.Equal(t, "02:00:5e:10:00:00:00:01", same)
+
+	// this EUI-64 address was not derived from an EUI-48 address
+	_, err = MAC("02:00:5e:10:00:00:00:01").ToEUI48()
+	require.Error(t, err)
+
+	ipoib := MAC("00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01")
+	assert.False(t, ipoib.IsEUI64())
+	_, err = ipoib.ToEUI48()
+	require.Error(t, err)
+	_, err = ipoib.ToEUI64()
+	require.Error(t, err)
+
+	invalid := MAC("not a mac")
+	assert.False(t, invalid.IsEUI64())
+	_, err = invalid.ToEUI48()
+	require.Error(t, err)
+	_, err = invalid.ToEUI64()
+	require.Error(t, err)
+}
@@ -0,0 +1,178 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ianaTopLevelTypes are the top-level media types registered by IANA
+var ianaTopLevelTypes = map[string]struct{}{
+	"application": {},
+	"audio":       {},
+	"font":        {},
+	"image":       {},
+	"message":     {},
+	"model":       {},
+	"multipart":   {},
+	"text":        {},
+	"video":       {},
+}
+
+func init() {
+	mt := MimeType("")
+	// register this format in the default registry
+	addBuiltin("mime-type", &mt, IsMimeType)
+}
+
+// splitMimeType returns the lower-cased type and subtype of a media type, ignoring its parameters
+func splitMimeType(str string) (string, string, bool) {
+	mediaType, _, err := mime.ParseMediaType(str)
+	if err != nil {
+		return "", "", false
+	}
+	typ, subtype, found := strings.Cut(mediaType, "/")
+	if !found {
+		return "", "", false
+	}
+	return typ, subtype, true
+}
+
+// IsMimeType returns true when the string is a valid media type (e.g. "text/plain; charset=utf-8").
+//
+// The top-level type must be registered by IANA, and the subtype may be any valid token.
+func IsMimeType(str string) bool {
+	typ, _, ok := splitMimeType(str)
+	if !ok {
+		return false
+	}
+	_, registered := ianaTopLevelTypes[typ]
+	return registered
+}
+
+// MimeType represents a media type, as specified by RFC 6838 (e.g. "application/json")
+//
+// swagger:strfmt mime-type
+type MimeType string
+
+// Type returns the lower-cased top-level type (e.g. "text"), or an empty string if the media type is invalid
+func (m MimeType) Type() string {
+	typ, _, _ := splitMimeType(string(m))
+	return typ
+}
+
+// Subtype returns the lower-cased subtype (e.g. "plain"), or an empty string if the media type is invalid
+func (m MimeType) Subtype() string {
+	_, subtype, _ := splitMimeType(string(m))
+	return subtype
+}
+
+// IsTextual returns true for "text/..." media types
+func (m MimeType) IsTextual() bool {
+	return m.Type() == "text"
+}
+
+// MarshalText turns this instance into text
+func (m MimeType) MarshalText() ([]byte, error) {
+	return []byte(string(m)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (m *MimeType) UnmarshalText(data []byte) error { // validation is performed later on
+	*m = MimeType(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (m *MimeType) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*m = MimeType(string(v))
+	case string:
+		*m = MimeType(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.MimeType from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (m MimeType) Value() (driver.Value, error) {
+	return driver.Value(string(m)), nil
+}
+
+func (m MimeType) String() string {
+	return string(m)
+}
+
+// MarshalJSON returns the MimeType as JSON
+func (m MimeType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(m))
+}
+
+// UnmarshalJSON sets the MimeType from JSON
+func (m *MimeType) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var mstr string
+	if err := json.Unmarshal(data, &mstr); err != nil {
+		return err
+	}
+	*m = MimeType(mstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (m MimeType) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": m.String()})
+}
+
+// UnmarshalBSON document into this value
+func (m *MimeType) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(string); ok {
+		*m = MimeType(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as MimeType")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (m *MimeType) DeepCopyInto(out *MimeType) {
+	*out = *m
+}
+
+// DeepCopy copies the receiver into a new MimeType.
+func (m *MimeType) DeepCopy() *MimeType {
+	if m == nil {
+		return nil
+	}
+	out := new(MimeType)
+	m.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatMimeType(t *testing.T) {
+	mimeType := MimeType("application/json")
+	str := "text/plain; charset=utf-8"
+	validMimeTypes := []string{
+		"application/json",
+		"application/vnd.api+json",
+		"application/octet-stream",
+		"audio/mpeg",
+		"font/woff2",
+		"image/svg+xml",
+		"message/rfc822",
+		"model/gltf+json",
+		"multipart/form-data; boundary=something",
+		"text/html;charset=UTF-8",
+		"video/mp4",
+		"Text/Plain",
+		"application/x-www-form-urlencoded",
+	}
+	invalidMimeTypes := []string{
+		"",
+		"json",
+		"text/",
+		"/plain",
+		"text/plain/extra",
+		"example/foo",
+		"x-custom/foo",
+		"*/*",
+		"chemical/x-pdb",
+		"text/pla in",
+		"text/plain; charset",
+		"text/plain;;",
+	}
+
+	testStringFormat(t, &mimeType, "mime-type", str, validMimeTypes, invalidMimeTypes)
+}
+
+func TestMimeType_Components(t *testing.T) {
+	mimeType := MimeType("Text/HTML; charset=utf-8")
+	assert.Equal(t, "text", mimeType.Type())
+	assert.Equal(t, "html", mimeType.Subtype())
+	assert.True(t, mimeType.IsTextual())
+
+	mimeType = MimeType("application/vnd.api+json")
+	assert.Equal(t, "application", mimeType.Type())
+	assert.Equal(t, "vnd.api+json", mimeType.Subtype())
+	assert.False(t, mimeType.IsTextual())
+
+	mimeType = MimeType("invalid")
+	assert.Empty(t, mimeType.Type())
+	assert.Empty(t, mimeType.Subtype())
+	assert.False(t, mimeType.IsTextual())
+}
+
+func TestDeepCopyMimeType(t *testing.T) {
+	mimeType := MimeType("application/json")
+	in := &mimeType
+
+	out := new(MimeType)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *MimeType
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
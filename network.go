@@ -0,0 +1,166 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	na := NetworkAddress("")
+	// register this format in the default registry
+	addBuiltin("network-address", &na, IsNetworkAddress)
+}
+
+// IsNetworkAddress returns true when the string is a valid "host:port" network address.
+//
+// The host must be a valid hostname, IPv4 or IPv6 address. IPv6 addresses must be enclosed
+// in square brackets, as required by RFC 3986 (e.g. "[::1]:8080").
+func IsNetworkAddress(str string) bool {
+	host, port, err := net.SplitHostPort(str)
+	if err != nil || !IsPort(port) {
+		return false
+	}
+
+	if strings.HasPrefix(str, "[") {
+		return govalidator.IsIPv6(host)
+	}
+
+	return IsHostname(host) || govalidator.IsIPv4(host)
+}
+
+// NetworkAddress represents a network address as "host:port"
+//
+// swagger:strfmt network-address
+type NetworkAddress string
+
+// Host returns the host part of this network address, or an empty string if it cannot be split.
+//
+// IPv6 addresses are returned without square brackets.
+func (a NetworkAddress) Host() string {
+	host, _, err := net.SplitHostPort(string(a))
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// Port returns the port part of this network address
+func (a NetworkAddress) Port() (PortNumber, error) {
+	_, port, err := net.SplitHostPort(string(a))
+	if err != nil {
+		return 0, err
+	}
+	return ParsePortNumber(port)
+}
+
+// Resolve resolves this network address as a TCP address
+func (a NetworkAddress) Resolve() (*net.TCPAddr, error) {
+	return net.ResolveTCPAddr("tcp", string(a))
+}
+
+// MarshalText turns this instance into text
+func (a NetworkAddress) MarshalText() ([]byte, error) {
+	return []byte(string(a)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (a *NetworkAddress) UnmarshalText(data []byte) error { // validation is performed later on
+	*a = NetworkAddress(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (a *NetworkAddress) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*a = NetworkAddress(string(v))
+	case string:
+		*a = NetworkAddress(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.NetworkAddress from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (a NetworkAddress) Value() (driver.Value, error) {
+	return driver.Value(string(a)), nil
+}
+
+func (a NetworkAddress) String() string {
+	return string(a)
+}
+
+// MarshalJSON returns the NetworkAddress as JSON
+func (a NetworkAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(a))
+}
+
+// UnmarshalJSON sets the NetworkAddress from JSON
+func (a *NetworkAddress) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var ustr string
+	if err := json.Unmarshal(data, &ustr); err != nil {
+		return err
+	}
+	*a = NetworkAddress(ustr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (a NetworkAddress) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": a.String()})
+}
+
+// UnmarshalBSON document into this value
+func (a *NetworkAddress) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*a = NetworkAddress(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as NetworkAddress")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (a *NetworkAddress) DeepCopyInto(out *NetworkAddress) {
+	*out = *a
+}
+
+// DeepCopy copies the receiver into a new NetworkAddress.
+func (a *NetworkAddress) DeepCopy() *NetworkAddress {
+	if a == nil {
+		return nil
+	}
+	out := new(NetworkAddress)
+	a.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,104 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatNetworkAddress(t *testing.T) {
+	addr := NetworkAddress("localhost:8080")
+	str := "example.com:443"
+	validAddresses := []string{
+		"localhost:0",
+		"www.example.com:65535",
+		"127.0.0.1:80",
+		"[::1]:8080",
+		"[2001:db8::1]:443",
+	}
+	invalidAddresses := []string{
+		"localhost",          // missing port
+		"localhost:",         // empty port
+		"localhost:65536",    // port out of range
+		"localhost:http",     // named port
+		"::1:8080",           // IPv6 without brackets
+		"[127.0.0.1]:80",     // IPv4 with brackets
+		"[example.com]:80",   // hostname with brackets
+		"exa mple.com:80",    // invalid hostname
+		"1.1.1.1.1:80",       // invalid IPv4
+		"[::1]:8080:8080",    // too many colons
+		"localhost:-1",       // negative port
+		"[fe80::1%eth0]:443", // zones are not supported
+	}
+
+	testStringFormat(t, &addr, "network-address", str, validAddresses, invalidAddresses)
+}
+
+func TestNetworkAddress_HostPort(t *testing.T) {
+	addr := NetworkAddress("[::1]:8080")
+	assert.Equal(t, "::1", addr.Host())
+	port, err := addr.Port()
+	require.NoError(t, err)
+	assert.Equal(t, PortNumber(8080), port)
+
+	addr = NetworkAddress("example.com:0")
+	assert.Equal(t, "example.com", addr.Host())
+	port, err = addr.Port()
+	require.NoError(t, err)
+	assert.Equal(t, PortNumber(0), port)
+
+	addr = NetworkAddress("example.com")
+	assert.Empty(t, addr.Host())
+	_, err = addr.Port()
+	require.Error(t, err)
+
+	_, err = NetworkAddress("example.com:99999").Port()
+	require.Error(t, err)
+}
+
+func TestNetworkAddress_Resolve(t *testing.T) {
+	tcpAddr, err := NetworkAddress("127.0.0.1:8080").Resolve()
+	require.NoError(t, err)
+	assert.True(t, tcpAddr.IP.Equal(net.IPv4(127, 0, 0, 1)))
+	assert.Equal(t, 8080, tcpAddr.Port)
+
+	tcpAddr, err = NetworkAddress("[::1]:443").Resolve()
+	require.NoError(t, err)
+	assert.True(t, tcpAddr.IP.Equal(net.IPv6loopback))
+	assert.Equal(t, 443, tcpAddr.Port)
+
+	_, err = NetworkAddress("::1:443").Resolve()
+	require.Error(t, err)
+}
+
+func TestDeepCopyNetworkAddress(t *testing.T) {
+	addr := NetworkAddress("localhost:8080")
+	in := &addr
+
+	out := new(NetworkAddress)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *NetworkAddress
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
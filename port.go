@@ -0,0 +1,162 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	p := PortNumber(0)
+	// register this format in the default registry
+	addBuiltin("port", &p, IsPort)
+}
+
+// IsPort returns true when the string is a decimal port number in the range [0, 65535]
+func IsPort(str string) bool {
+	_, err := ParsePortNumber(str)
+	return err == nil
+}
+
+// ParsePortNumber parses a port number from its decimal string representation
+func ParsePortNumber(str string) (PortNumber, error) {
+	if str == "" || str[0] == '+' || str[0] == '-' {
+		return 0, fmt.Errorf("invalid port number: %q", str)
+	}
+	p, err := strconv.ParseUint(str, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port number: %q: %w", str, err)
+	}
+	return PortNumber(p), nil
+}
+
+// PortNumber represents a TCP or UDP port number
+//
+// swagger:strfmt port
+type PortNumber uint16
+
+// IsPrivileged returns true when this port number is below 1024
+func (p PortNumber) IsPrivileged() bool {
+	return p < 1024
+}
+
+// MarshalText turns this instance into text
+func (p PortNumber) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (p *PortNumber) UnmarshalText(data []byte) error {
+	pp, err := ParsePortNumber(string(data))
+	if err != nil {
+		return err
+	}
+	*p = pp
+	return nil
+}
+
+// Scan reads a PortNumber value from database driver type.
+func (p *PortNumber) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case int64:
+		if v < 0 || v > math.MaxUint16 {
+			return fmt.Errorf("cannot sql.Scan() strfmt.PortNumber from out of range value: %d", v)
+		}
+		*p = PortNumber(v)
+	case []byte:
+		return p.UnmarshalText(v)
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case nil:
+		*p = PortNumber(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.PortNumber from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts PortNumber to a primitive value ready to be written to a database.
+func (p PortNumber) Value() (driver.Value, error) {
+	return driver.Value(int64(p)), nil
+}
+
+// String converts this port number to a string
+func (p PortNumber) String() string {
+	return strconv.FormatUint(uint64(p), 10)
+}
+
+// MarshalJSON returns the PortNumber as a JSON number
+func (p PortNumber) MarshalJSON() ([]byte, error) {
+	return json.Marshal(uint16(p))
+}
+
+// UnmarshalJSON sets the PortNumber from a JSON number
+func (p *PortNumber) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+
+	var pp uint16
+	if err := json.Unmarshal(data, &pp); err != nil {
+		return err
+	}
+	*p = PortNumber(pp)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (p PortNumber) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": int32(p)})
+}
+
+// UnmarshalBSON document into this value
+func (p *PortNumber) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	switch v := m["data"].(type) {
+	case int32:
+		return p.Scan(int64(v))
+	case int64:
+		return p.Scan(v)
+	default:
+		return errors.New("couldn't unmarshal bson bytes value as PortNumber")
+	}
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (p *PortNumber) DeepCopyInto(out *PortNumber) {
+	*out = *p
+}
+
+// DeepCopy copies the receiver into a new PortNumber.
+func (p *PortNumber) DeepCopy() *PortNumber {
+	if p == nil {
+		return nil
+	}
+	out := new(PortNumber)
+	p.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,122 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var _ sql.Scanner = new(PortNumber)
+var _ driver.Valuer = PortNumber(0)
+
+func TestPortNumber(t *testing.T) {
+	var p PortNumber
+
+	require.NoError(t, p.UnmarshalText([]byte("8080")))
+	assert.Equal(t, PortNumber(8080), p)
+	require.Error(t, p.UnmarshalText([]byte("yada")))
+	require.Error(t, p.UnmarshalText([]byte("65536")))
+	require.Error(t, p.UnmarshalText([]byte("-1")))
+	require.Error(t, p.UnmarshalText([]byte("")))
+
+	txt, err := p.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "8080", string(txt))
+	assert.Equal(t, "8080", p.String())
+
+	require.NoError(t, p.UnmarshalJSON([]byte("443")))
+	assert.Equal(t, PortNumber(443), p)
+	require.Error(t, p.UnmarshalJSON([]byte(`"443"`)))
+	require.Error(t, p.UnmarshalJSON([]byte("70000")))
+	require.NoError(t, p.UnmarshalJSON([]byte(jsonNull)))
+	assert.Equal(t, PortNumber(443), p)
+
+	b, err := p.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("443"), b)
+
+	bsonData, err := bson.Marshal(&p)
+	require.NoError(t, err)
+
+	var pCopy PortNumber
+	require.NoError(t, bson.Unmarshal(bsonData, &pCopy))
+	assert.Equal(t, p, pCopy)
+
+	bsonData, err = bson.Marshal(bson.M{"data": "443"})
+	require.NoError(t, err)
+	require.Error(t, bson.Unmarshal(bsonData, &pCopy))
+}
+
+func TestPortNumber_Scan(t *testing.T) {
+	for _, value := range []interface{}{int64(8080), "8080", []byte("8080")} {
+		var p PortNumber
+		require.NoError(t, p.Scan(value))
+		assert.Equal(t, PortNumber(8080), p)
+	}
+
+	var p PortNumber
+	require.NoError(t, p.Scan(nil))
+	assert.Equal(t, PortNumber(0), p)
+
+	require.Error(t, p.Scan(int64(-1)))
+	require.Error(t, p.Scan(int64(65536)))
+	require.Error(t, p.Scan("http"))
+	require.Error(t, p.Scan(8080.0))
+
+	v, err := PortNumber(22).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(22), v)
+}
+
+func TestPortNumber_IsPrivileged(t *testing.T) {
+	assert.True(t, PortNumber(0).IsPrivileged())
+	assert.True(t, PortNumber(443).IsPrivileged())
+	assert.True(t, PortNumber(1023).IsPrivileged())
+	assert.False(t, PortNumber(1024).IsPrivileged())
+	assert.False(t, PortNumber(65535).IsPrivileged())
+}
+
+func TestIsPort(t *testing.T) {
+	for _, valid := range []string{"0", "80", "443", "65535"} {
+		assert.Truef(t, IsPort(valid), "expected %q to be a valid port", valid)
+		testValid(t, "port", valid)
+	}
+	for _, invalid := range []string{"", "-1", "+80", "65536", "80.0", "0x50", " 80", "http"} {
+		assert.Falsef(t, IsPort(invalid), "expected %q to be an invalid port", invalid)
+		testInvalid(t, "port", invalid)
+	}
+}
+
+func TestDeepCopyPortNumber(t *testing.T) {
+	p := PortNumber(8080)
+	in := &p
+
+	out := new(PortNumber)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *PortNumber
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
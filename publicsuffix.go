@@ -0,0 +1,82 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// suffixDomain returns this hostname in the form expected by the publicsuffix package:
+// lower case, Punycode-encoded and without a trailing dot.
+func (h Hostname) suffixDomain() (string, error) {
+	domain := strings.TrimSuffix(string(h), ".")
+	if domain == "" {
+		return "", errors.New("cannot determine the public suffix of an empty hostname")
+	}
+	if net.ParseIP(domain) != nil {
+		return "", fmt.Errorf("cannot determine the public suffix of IP address %q", domain)
+	}
+	if strings.HasPrefix(domain, ".") || strings.Contains(domain, "..") {
+		return "", fmt.Errorf("cannot determine the public suffix of %q: empty label", string(h))
+	}
+
+	domain, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", err
+	}
+	return domain, nil
+}
+
+// PublicSuffix returns the public suffix of this hostname, as per the public suffix list (https://publicsuffix.org),
+// e.g. "co.uk" for "www.api.foo.co.uk".
+//
+// Hostnames are compared in their lower case, Punycode-encoded form, which is the form of the returned suffix.
+// Suffixes unknown to the list default to the last label of the hostname.
+func (h Hostname) PublicSuffix() (string, error) {
+	domain, err := h.suffixDomain()
+	if err != nil {
+		return "", err
+	}
+	suffix, _ := publicsuffix.PublicSuffix(domain)
+	return suffix, nil
+}
+
+// RegisteredDomain returns the registered domain of this hostname, i.e. its public suffix
+// plus one label (eTLD+1), e.g. "foo.co.uk" for "www.api.foo.co.uk".
+//
+// An error is returned when the hostname is itself a public suffix.
+func (h Hostname) RegisteredDomain() (string, error) {
+	domain, err := h.suffixDomain()
+	if err != nil {
+		return "", err
+	}
+	return publicsuffix.EffectiveTLDPlusOne(domain)
+}
+
+// IsPublicSuffix returns true when this hostname is itself a public suffix, e.g. "co.uk" or "github.io"
+func (h Hostname) IsPublicSuffix() (bool, error) {
+	domain, err := h.suffixDomain()
+	if err != nil {
+		return false, err
+	}
+	suffix, _ := publicsuffix.PublicSuffix(domain)
+	return suffix == domain, nil
+}
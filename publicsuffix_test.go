@@ -0,0 +1,67 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostname_PublicSuffix(t *testing.T) {
+	for _, tc := range []struct {
+		host       Hostname
+		suffix     string
+		registered string
+	}{
+		{"www.api.foo.co.uk", "co.uk", "foo.co.uk"},
+		{"WWW.Example.COM.", "com", "example.com"},
+		{"example.com", "com", "example.com"},
+		{"user.github.io", "github.io", "user.github.io"},
+		{"www.bücher.de", "de", "xn--bcher-kva.de"},
+		{"service.internal-unknown-tld", "internal-unknown-tld", "service.internal-unknown-tld"},
+	} {
+		suffix, err := tc.host.PublicSuffix()
+		require.NoError(t, err)
+		assert.Equalf(t, tc.suffix, suffix, "unexpected public suffix for %s", tc.host)
+
+		registered, err := tc.host.RegisteredDomain()
+		require.NoError(t, err)
+		assert.Equalf(t, tc.registered, registered, "unexpected registered domain for %s", tc.host)
+
+		isSuffix, err := tc.host.IsPublicSuffix()
+		require.NoError(t, err)
+		assert.False(t, isSuffix)
+	}
+
+	for _, suffix := range []Hostname{"co.uk", "com", "github.io", "CO.UK."} {
+		isSuffix, err := suffix.IsPublicSuffix()
+		require.NoError(t, err)
+		assert.Truef(t, isSuffix, "expected %s to be a public suffix", suffix)
+
+		_, err = suffix.RegisteredDomain()
+		require.Error(t, err)
+	}
+
+	for _, invalid := range []Hostname{"", ".", "192.168.0.1", "::1", ".example.com", "www..example.com"} {
+		_, err := invalid.PublicSuffix()
+		require.Errorf(t, err, "expected an error for %q", invalid)
+		_, err = invalid.RegisteredDomain()
+		require.Error(t, err)
+		_, err = invalid.IsPublicSuffix()
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	// register formats in the default registry:
+	//   - uri-reference
+	//   - iri-reference
+	ur := URIReference("")
+	addBuiltin("uri-reference", &ur, IsURIReference)
+
+	ir := IRIReference("")
+	addBuiltin("iri-reference", &ir, IsIRIReference)
+}
+
+// IsURIReference returns true when the string is a valid URI reference, either absolute or relative
+// (e.g. "../path", "//host/path").
+//
+// Only ASCII characters are allowed: see IsIRIReference for internationalized references.
+func IsURIReference(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return IsIRIReference(str)
+}
+
+// IsIRIReference returns true when the string is a valid IRI reference, either absolute or relative.
+//
+// Unlike URI references, IRI references may contain Unicode characters.
+func IsIRIReference(str string) bool {
+	if !utf8.ValidString(str) {
+		return false
+	}
+	_, err := url.Parse(str)
+	return err == nil
+}
+
+// URIReference represents a URI reference, which may be absolute or relative
+//
+// swagger:strfmt uri-reference
+type URIReference string
+
+// MarshalText turns this instance into text
+func (u URIReference) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *URIReference) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = URIReference(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *URIReference) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = URIReference(string(v))
+	case string:
+		*u = URIReference(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.URIReference from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u URIReference) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u URIReference) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the URIReference as JSON
+func (u URIReference) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(u))
+}
+
+// UnmarshalJSON sets the URIReference from JSON
+func (u *URIReference) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var ustr string
+	if err := json.Unmarshal(data, &ustr); err != nil {
+		return err
+	}
+	*u = URIReference(ustr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (u URIReference) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": u.String()})
+}
+
+// UnmarshalBSON document into this value
+func (u *URIReference) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*u = URIReference(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as URIReference")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (u *URIReference) DeepCopyInto(out *URIReference) {
+	*out = *u
+}
+
+// DeepCopy copies the receiver into a new URIReference.
+func (u *URIReference) DeepCopy() *URIReference {
+	if u == nil {
+		return nil
+	}
+	out := new(URIReference)
+	u.DeepCopyInto(out)
+	return out
+}
+
+// IRIReference represents an internationalized resource identifier (IRI) reference,
+// which may be absolute or relative
+//
+// swagger:strfmt iri-reference
+type IRIReference string
+
+// MarshalText turns this instance into text
+func (u IRIReference) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *IRIReference) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = IRIReference(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *IRIReference) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = IRIReference(string(v))
+	case string:
+		*u = IRIReference(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IRIReference from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u IRIReference) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u IRIReference) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the IRIReference as JSON
+func (u IRIReference) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(u))
+}
+
+// UnmarshalJSON sets the IRIReference from JSON
+func (u *IRIReference) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var ustr string
+	if err := json.Unmarshal(data, &ustr); err != nil {
+		return err
+	}
+	*u = IRIReference(ustr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (u IRIReference) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": u.String()})
+}
+
+// UnmarshalBSON document into this value
+func (u *IRIReference) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*u = IRIReference(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as IRIReference")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (u *IRIReference) DeepCopyInto(out *IRIReference) {
+	*out = *u
+}
+
+// DeepCopy copies the receiver into a new IRIReference.
+func (u *IRIReference) DeepCopy() *IRIReference {
+	if u == nil {
+		return nil
+	}
+	out := new(IRIReference)
+	u.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,96 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatURIReference(t *testing.T) {
+	ref := URIReference("http://example.com/path")
+	str := "../path"
+	validRefs := []string{
+		"",
+		"http://example.com",
+		"//host/path",
+		"/absolute/path",
+		"relative/path?q=1#frag",
+		"#fragment",
+		"?query",
+	}
+	invalidRefs := []string{
+		"http://[::1",
+		"%zz",
+		"/bücher",
+		"http://example.com/#fragé",
+	}
+
+	testStringFormat(t, &ref, "uri-reference", str, validRefs, invalidRefs)
+
+	// the uri format rejects relative references
+	testInvalid(t, "uri", str)
+}
+
+func TestFormatIRIReference(t *testing.T) {
+	ref := IRIReference("http://example.com/path")
+	str := "../bücher"
+	validRefs := []string{
+		"",
+		"//host/path",
+		"/bücher?q=über#fragé",
+		"http://例え.jp/パス",
+	}
+	invalidRefs := []string{
+		"http://[::1",
+		"%zz",
+		"/\xff",
+	}
+
+	testStringFormat(t, &ref, "iri-reference", str, validRefs, invalidRefs)
+}
+
+func TestDeepCopyURIReference(t *testing.T) {
+	ref := URIReference("../path")
+	in := &ref
+
+	out := new(URIReference)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *URIReference
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
+
+func TestDeepCopyIRIReference(t *testing.T) {
+	ref := IRIReference("../bücher")
+	in := &ref
+
+	out := new(IRIReference)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *IRIReference
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
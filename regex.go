@@ -0,0 +1,138 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	rx := RegexPattern("")
+	// register this format in the default registry
+	addBuiltin("regex", &rx, IsRegexPattern)
+}
+
+// IsRegexPattern returns true when the string is a valid go regular expression
+func IsRegexPattern(str string) bool {
+	_, err := regexp.Compile(str)
+	return err == nil
+}
+
+// RegexPattern represents a regular expression, using the syntax supported by the go regexp package
+//
+// swagger:strfmt regex
+type RegexPattern string
+
+// Compile parses this pattern and returns a regular expression
+func (r RegexPattern) Compile() (*regexp.Regexp, error) {
+	return regexp.Compile(string(r))
+}
+
+// MustCompile is like Compile, but panics if this pattern cannot be parsed
+func (r RegexPattern) MustCompile() *regexp.Regexp {
+	return regexp.MustCompile(string(r))
+}
+
+// MarshalText turns this instance into text
+func (r RegexPattern) MarshalText() ([]byte, error) {
+	return []byte(string(r)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (r *RegexPattern) UnmarshalText(data []byte) error { // validation is performed later on
+	*r = RegexPattern(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (r *RegexPattern) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*r = RegexPattern(string(v))
+	case string:
+		*r = RegexPattern(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.RegexPattern from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (r RegexPattern) Value() (driver.Value, error) {
+	return driver.Value(string(r)), nil
+}
+
+func (r RegexPattern) String() string {
+	return string(r)
+}
+
+// MarshalJSON returns the RegexPattern as JSON
+func (r RegexPattern) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(r))
+}
+
+// UnmarshalJSON sets the RegexPattern from JSON
+func (r *RegexPattern) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var rstr string
+	if err := json.Unmarshal(data, &rstr); err != nil {
+		return err
+	}
+	*r = RegexPattern(rstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (r RegexPattern) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": r.String()})
+}
+
+// UnmarshalBSON document into this value
+func (r *RegexPattern) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*r = RegexPattern(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as RegexPattern")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (r *RegexPattern) DeepCopyInto(out *RegexPattern) {
+	*out = *r
+}
+
+// DeepCopy copies the receiver into a new RegexPattern.
+func (r *RegexPattern) DeepCopy() *RegexPattern {
+	if r == nil {
+		return nil
+	}
+	out := new(RegexPattern)
+	r.DeepCopyInto(out)
+	return out
+}
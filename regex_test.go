@@ -0,0 +1,80 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatRegexPattern(t *testing.T) {
+	pattern := RegexPattern("^[a-z]+$")
+	str := "^[0-9]{3}-[0-9]{4}$"
+	validPatterns := []string{
+		"",
+		"^[a-z]+$",
+		"a|b",
+		`(?i)hello\s+world`,
+		`(?P<name>\w+)`,
+		`\p{Greek}+`,
+		"[[:alpha:]]",
+	}
+	invalidPatterns := []string{
+		"[unclosed",
+		"(unclosed",
+		"unopened)",
+		"a**",
+		"*a",
+		`\`,
+		"x{2,1}",
+		"(?<=lookbehind)",
+		`\1`,
+	}
+
+	testStringFormat(t, &pattern, "regex", str, validPatterns, invalidPatterns)
+}
+
+func TestRegexPattern_Compile(t *testing.T) {
+	rx, err := RegexPattern("^[a-z]+$").Compile()
+	require.NoError(t, err)
+	assert.True(t, rx.MatchString("abc"))
+	assert.False(t, rx.MatchString("ABC"))
+
+	_, err = RegexPattern("[unclosed").Compile()
+	require.Error(t, err)
+
+	assert.True(t, RegexPattern(`^\d+$`).MustCompile().MatchString("123"))
+	assert.Panics(t, func() {
+		_ = RegexPattern("[unclosed").MustCompile()
+	})
+}
+
+func TestDeepCopyRegexPattern(t *testing.T) {
+	pattern := RegexPattern("^[a-z]+$")
+	in := &pattern
+
+	out := new(RegexPattern)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *RegexPattern
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
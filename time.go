@@ -37,7 +37,7 @@ var (
 
 func init() {
 	dt := DateTime{}
-	Default.Add("datetime", &dt, IsDateTime)
+	addBuiltin("datetime", &dt, IsDateTime)
 }
 
 // IsDateTime returns true when the string is a valid date-time
@@ -97,6 +97,10 @@ var (
 
 	// DefaultTimeLocation provides a location for a time when the time zone is not encoded in the string (ex: ISO8601 Local variants).
 	DefaultTimeLocation = time.UTC
+
+	// DateTimeMarshalZeroAsNull makes DateTime.MarshalJSON render zero valued date times as JSON null.
+	// By default, zero valued date times are rendered as "0001-01-01T00:00:00.000Z".
+	DateTimeMarshalZeroAsNull = false
 )
 
 // ParseDateTime parses a string that represents an ISO8601 time or a unix epoch
@@ -116,6 +120,57 @@ func ParseDateTime(data string) (DateTime, error) {
 	return DateTime{}, lastError
 }
 
+// DateTimeParseMode tells how permissive ParseDateTimeWithMode is about the accepted formats
+type DateTimeParseMode uint8
+
+const (
+	// DateTimeParseLenient accepts all the DateTimeFormats, like ParseDateTime. This is the default.
+	DateTimeParseLenient DateTimeParseMode = iota
+	// DateTimeParseStrict only accepts RFC 3339 date-times, with optional fractional seconds and a mandatory time zone offset
+	DateTimeParseStrict
+)
+
+// DateTimeParseError is returned by ParseDateTimeStrict when a string is not a RFC 3339 date-time
+type DateTimeParseError struct {
+	Value string
+
+	// err is the error reported by the time package, if any
+	err error
+}
+
+func (e *DateTimeParseError) Error() string {
+	return fmt.Sprintf("strfmt: %q is not a RFC 3339 date-time", e.Value)
+}
+
+// Unwrap returns the error reported by the time package, if any
+func (e *DateTimeParseError) Unwrap() error {
+	return e.err
+}
+
+// ParseDateTimeStrict parses a string that represents a RFC 3339 date-time, e.g. "2014-12-15T08:00:00.000Z".
+//
+// Unlike ParseDateTime, other ISO8601 variants (e.g. "2014-12-15 08:00:00") and empty strings
+// are rejected with a *DateTimeParseError.
+func ParseDateTimeStrict(data string) (DateTime, error) {
+	dd, err := time.Parse(time.RFC3339Nano, data)
+	if err != nil {
+		return DateTime{}, &DateTimeParseError{Value: data, err: err}
+	}
+	return DateTime(dd), nil
+}
+
+// ParseDateTimeWithMode parses a string that represents a date-time, either like ParseDateTime or like ParseDateTimeStrict
+func ParseDateTimeWithMode(data string, mode DateTimeParseMode) (DateTime, error) {
+	switch mode {
+	case DateTimeParseLenient:
+		return ParseDateTime(data)
+	case DateTimeParseStrict:
+		return ParseDateTimeStrict(data)
+	default:
+		return DateTime{}, fmt.Errorf("strfmt: unknown date-time parse mode: %d", mode)
+	}
+}
+
 // DateTime is a time but it serializes to ISO8601 format with millis
 // It knows how to read 3 different variations of a RFC3339 date time.
 // Most APIs we encounter want either millisecond or second precision times.
@@ -129,8 +184,31 @@ func NewDateTime() DateTime {
 	return DateTime(time.Unix(0, 0).UTC())
 }
 
-// String converts this time to a string
+// String converts this time to a string, in its own time zone (see StringLocal).
+//
+// Unlike marshaling, String is not affected by NormalizeTimeForMarshal.
 func (t DateTime) String() string {
+	return t.StringLocal()
+}
+
+// StringLocal formats this time with MarshalFormat, in its own time zone
+func (t DateTime) StringLocal() string {
+	return time.Time(t).Format(MarshalFormat)
+}
+
+// StringUTC formats this time with MarshalFormat, in UTC
+func (t DateTime) StringUTC() string {
+	return time.Time(t).UTC().Format(MarshalFormat)
+}
+
+// GoString returns a Go representation of this time, e.g. strfmt.DateTime(time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)),
+// for the %#v verb of the fmt package
+func (t DateTime) GoString() string {
+	return "strfmt.DateTime(" + time.Time(t).GoString() + ")"
+}
+
+// marshalString formats this time with MarshalFormat, after normalization by NormalizeTimeForMarshal
+func (t DateTime) marshalString() string {
 	return NormalizeTimeForMarshal(time.Time(t)).Format(MarshalFormat)
 }
 
@@ -152,7 +230,7 @@ func (t *DateTime) IsUnixZero() bool {
 
 // MarshalText implements the text marshaller interface
 func (t DateTime) MarshalText() ([]byte, error) {
-	return []byte(t.String()), nil
+	return []byte(t.marshalString()), nil
 }
 
 // UnmarshalText implements the text unmarshaller interface
@@ -166,8 +244,10 @@ func (t *DateTime) UnmarshalText(text []byte) error {
 }
 
 // Scan scans a DateTime value from database driver type.
+//
+// Besides strings and time.Time values, int64 values are accepted as unix timestamps in nanoseconds (UTC).
 func (t *DateTime) Scan(raw interface{}) error {
-	// TODO: case int64: and case float64: ?
+	// TODO: case float64: ?
 	switch v := raw.(type) {
 	case []byte:
 		return t.UnmarshalText(v)
@@ -175,6 +255,8 @@ func (t *DateTime) Scan(raw interface{}) error {
 		return t.UnmarshalText([]byte(v))
 	case time.Time:
 		*t = DateTime(v)
+	case int64:
+		*t = DateTime(time.Unix(0, v).UTC())
 	case nil:
 		*t = DateTime{}
 	default:
@@ -186,12 +268,25 @@ func (t *DateTime) Scan(raw interface{}) error {
 
 // Value converts DateTime to a primitive value ready to written to a database.
 func (t DateTime) Value() (driver.Value, error) {
-	return driver.Value(t.String()), nil
+	return driver.Value(t.marshalString()), nil
 }
 
-// MarshalJSON returns the DateTime as JSON
+// MarshalJSON returns the DateTime as JSON.
+//
+// Zero valued date times are rendered as null when DateTimeMarshalZeroAsNull is set.
 func (t DateTime) MarshalJSON() ([]byte, error) {
-	return json.Marshal(NormalizeTimeForMarshal(time.Time(t)).Format(MarshalFormat))
+	if DateTimeMarshalZeroAsNull && t.IsZero() {
+		return []byte(jsonNull), nil
+	}
+	return json.Marshal(t.marshalString())
+}
+
+// MarshalJSONOrNull returns the DateTime as JSON, or null if the date time is a zero value
+func (t DateTime) MarshalJSONOrNull() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(jsonNull), nil
+	}
+	return json.Marshal(t.marshalString())
 }
 
 // UnmarshalJSON sets the DateTime from JSON
@@ -319,3 +414,152 @@ func (t *DateTime) UnmarshalBinary(data []byte) error {
 func (t DateTime) Equal(t2 DateTime) bool {
 	return time.Time(t).Equal(time.Time(t2))
 }
+
+// EqualDay checks if two DateTime instances fall on the same calendar day in UTC
+func (t DateTime) EqualDay(t2 DateTime) bool {
+	return t.SameDayAs(t2, time.UTC)
+}
+
+// EqualHour checks if two DateTime instances fall within the same hour in UTC
+func (t DateTime) EqualHour(t2 DateTime) bool {
+	return time.Time(t).Truncate(time.Hour).Equal(time.Time(t2).Truncate(time.Hour))
+}
+
+// EqualMinute checks if two DateTime instances fall within the same minute
+func (t DateTime) EqualMinute(t2 DateTime) bool {
+	return time.Time(t).Truncate(time.Minute).Equal(time.Time(t2).Truncate(time.Minute))
+}
+
+// SameDayAs checks if two DateTime instances fall on the same calendar day in the given location.
+// A nil location stands for UTC.
+func (t DateTime) SameDayAs(t2 DateTime, loc *time.Location) bool {
+	if loc == nil {
+		loc = time.UTC
+	}
+	y1, m1, d1 := time.Time(t).In(loc).Date()
+	y2, m2, d2 := time.Time(t2).In(loc).Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// Age returns the time elapsed since this DateTime, negative when it is in the future
+func (t DateTime) Age() Duration {
+	return Duration(time.Since(time.Time(t)))
+}
+
+// Until returns the time remaining until this DateTime, negative when it is in the past
+func (t DateTime) Until() Duration {
+	return Duration(time.Until(time.Time(t)))
+}
+
+// IsPast returns true when this DateTime is before the current time
+func (t DateTime) IsPast() bool {
+	return time.Time(t).Before(time.Now())
+}
+
+// IsFuture returns true when this DateTime is after the current time
+func (t DateTime) IsFuture() bool {
+	return time.Time(t).After(time.Now())
+}
+
+// DaysSince returns the number of full 24 hours periods elapsed since this DateTime, negative when it is in the future
+func (t DateTime) DaysSince() int {
+	return int(time.Since(time.Time(t)) / (24 * time.Hour))
+}
+
+// DaysUntil returns the number of full 24 hours periods remaining until this DateTime, negative when it is in the past
+func (t DateTime) DaysUntil() int {
+	return int(time.Until(time.Time(t)) / (24 * time.Hour))
+}
+
+// protobuf wire format of google.protobuf.Timestamp: field 1 is the int64 seconds, field 2 the int32 nanos
+const (
+	protoWireVarint  = 0
+	protoWireFixed64 = 1
+	protoWireBytes   = 2
+	protoWireFixed32 = 5
+
+	protoTimestampSeconds = 1
+	protoTimestampNanos   = 2
+)
+
+// ProtoSeconds returns the seconds field of the google.protobuf.Timestamp representing this DateTime,
+// i.e. its unix time in seconds
+func (t DateTime) ProtoSeconds() int64 {
+	return time.Time(t).Unix()
+}
+
+// ProtoNanos returns the nanos field of the google.protobuf.Timestamp representing this DateTime,
+// i.e. its non-negative fraction of a second in nanoseconds
+func (t DateTime) ProtoNanos() int32 {
+	return int32(time.Time(t).Nanosecond())
+}
+
+// MarshalProto encodes this DateTime as a google.protobuf.Timestamp message, in the protobuf binary wire format.
+//
+// As for proto3 messages, zero fields are omitted.
+func (t DateTime) MarshalProto() ([]byte, error) {
+	buf := make([]byte, 0, 2*(1+binary.MaxVarintLen64))
+	if seconds := t.ProtoSeconds(); seconds != 0 {
+		buf = binary.AppendUvarint(buf, protoTimestampSeconds<<3|protoWireVarint)
+		buf = binary.AppendUvarint(buf, uint64(seconds))
+	}
+	if nanos := t.ProtoNanos(); nanos != 0 {
+		buf = binary.AppendUvarint(buf, protoTimestampNanos<<3|protoWireVarint)
+		buf = binary.AppendUvarint(buf, uint64(nanos))
+	}
+	return buf, nil
+}
+
+// UnmarshalProto decodes a google.protobuf.Timestamp message in the protobuf binary wire format.
+//
+// Unknown fields are skipped. The resulting DateTime is in UTC.
+func (t *DateTime) UnmarshalProto(data []byte) error {
+	var seconds, nanos int64
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errors.New("invalid protobuf timestamp: malformed field key")
+		}
+		data = data[n:]
+
+		field, wire := key>>3, key&7
+		switch wire {
+		case protoWireVarint:
+			v, n := binary.Uvarint(data)
+			if n <= 0 {
+				return errors.New("invalid protobuf timestamp: malformed varint")
+			}
+			data = data[n:]
+			switch field {
+			case protoTimestampSeconds:
+				seconds = int64(v)
+			case protoTimestampNanos:
+				nanos = int64(int32(v))
+			}
+		case protoWireFixed64:
+			if len(data) < 8 {
+				return errors.New("invalid protobuf timestamp: truncated field")
+			}
+			data = data[8:]
+		case protoWireFixed32:
+			if len(data) < 4 {
+				return errors.New("invalid protobuf timestamp: truncated field")
+			}
+			data = data[4:]
+		case protoWireBytes:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return errors.New("invalid protobuf timestamp: truncated field")
+			}
+			data = data[uint64(n)+l:]
+		default:
+			return fmt.Errorf("invalid protobuf timestamp: unsupported wire type %d", wire)
+		}
+	}
+
+	if nanos < 0 || nanos >= int64(time.Second) {
+		return fmt.Errorf("invalid protobuf timestamp: nanos out of range: %d", nanos)
+	}
+	*t = DateTime(time.Unix(seconds, nanos).UTC())
+	return nil
+}
@@ -17,6 +17,9 @@ package strfmt
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -268,13 +271,24 @@ func TestDateTime_Scan_Failed(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, zero, pp)
 
-	err = pp.Scan(int64(0))
+	err = pp.Scan(float64(0))
 	require.Error(t, err)
 
-	err = pp.Scan(float64(0))
+	err = pp.Scan(0)
 	require.Error(t, err)
 }
 
+func TestDateTime_ScanUnixNanos(t *testing.T) {
+	var dt DateTime
+	require.NoError(t, dt.Scan(int64(0)))
+	assert.Equal(t, DateTime(time.Unix(0, 0).UTC()), dt)
+
+	ref := time.Date(2024, time.February, 29, 12, 13, 14, 123456789, time.UTC)
+	require.NoError(t, dt.Scan(ref.UnixNano()))
+	assert.Equal(t, DateTime(ref), dt)
+	assert.Equal(t, time.UTC, time.Time(dt).Location())
+}
+
 func TestDateTime_BSON(t *testing.T) {
 	for caseNum, example := range testCases {
 		t.Logf("Case #%d", caseNum)
@@ -343,6 +357,27 @@ func TestGobEncodingDateTime(t *testing.T) {
 	assert.Equal(t, now.Second(), time.Time(result).Second())
 }
 
+func TestGobEncodingDateTime_Nanoseconds(t *testing.T) {
+	now := time.Now()
+	if now.Nanosecond()%int(time.Microsecond) == 0 {
+		now = now.Add(123 * time.Nanosecond)
+	}
+	require.NotZero(t, now.Nanosecond()%int(time.Microsecond))
+
+	for _, in := range []DateTime{
+		DateTime(now),
+		DateTime(time.Date(2024, time.February, 29, 23, 59, 59, 999999999, time.FixedZone("UTC-7", -7*3600))),
+	} {
+		b := bytes.Buffer{}
+		require.NoError(t, gob.NewEncoder(&b).Encode(in))
+
+		var result DateTime
+		require.NoError(t, gob.NewDecoder(&b).Decode(&result))
+		assert.Truef(t, time.Time(in).Equal(time.Time(result)), "expected %v, got %v", in, result)
+		assert.Equal(t, time.Time(in).Nanosecond(), time.Time(result).Nanosecond())
+	}
+}
+
 func TestDateTime_Equal(t *testing.T) {
 	t.Parallel()
 
@@ -353,3 +388,221 @@ func TestDateTime_Equal(t *testing.T) {
 	assert.True(t, dt1.Equal(dt1), "DateTime instances should be equal")
 	assert.False(t, dt1.Equal(dt2), "DateTime instances should not be equal")
 }
+
+func TestDateTime_Age(t *testing.T) {
+	t.Parallel()
+
+	past := DateTime(time.Now().Add(-50 * time.Hour))
+	assert.True(t, past.IsPast())
+	assert.False(t, past.IsFuture())
+	assert.GreaterOrEqual(t, time.Duration(past.Age()), 50*time.Hour)
+	assert.Less(t, time.Duration(past.Until()), -49*time.Hour)
+	assert.Equal(t, 2, past.DaysSince())
+	assert.Equal(t, -2, past.DaysUntil())
+
+	future := DateTime(time.Now().Add(50 * time.Hour))
+	assert.False(t, future.IsPast())
+	assert.True(t, future.IsFuture())
+	assert.Less(t, time.Duration(future.Age()), -49*time.Hour)
+	assert.LessOrEqual(t, time.Duration(future.Until()), 50*time.Hour)
+	assert.Greater(t, time.Duration(future.Until()), 49*time.Hour)
+	assert.Equal(t, -2, future.DaysSince())
+	assert.Equal(t, 2, future.DaysUntil())
+}
+
+func TestDateTime_Proto(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		dt      DateTime
+		seconds int64
+		nanos   int32
+		wire    []byte
+	}{
+		{name: "epoch", dt: DateTime(time.Unix(0, 0).UTC()), wire: []byte{}},
+		{name: "seconds and nanos", dt: DateTime(time.Unix(1, 2).UTC()), seconds: 1, nanos: 2, wire: []byte{0x08, 0x01, 0x10, 0x02}},
+		{name: "multi-byte varints", dt: DateTime(time.Unix(300, 999999999).UTC()), seconds: 300, nanos: 999999999,
+			wire: []byte{0x08, 0xac, 0x02, 0x10, 0xff, 0x93, 0xeb, 0xdc, 0x03}},
+		{name: "before epoch", dt: DateTime(time.Unix(-1, 500).UTC()), seconds: -1, nanos: 500,
+			wire: []byte{0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x10, 0xf4, 0x03}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.seconds, tc.dt.ProtoSeconds())
+			assert.Equal(t, tc.nanos, tc.dt.ProtoNanos())
+
+			wire, err := tc.dt.MarshalProto()
+			require.NoError(t, err)
+			assert.Equal(t, tc.wire, wire)
+
+			var back DateTime
+			require.NoError(t, back.UnmarshalProto(wire))
+			assert.True(t, tc.dt.Equal(back))
+		})
+	}
+
+	t.Run("should skip unknown fields", func(t *testing.T) {
+		var dt DateTime
+		wire := []byte{0x18, 0x05, 0x22, 0x02, 'h', 'i', 0x08, 0x01, 0x2d, 0, 0, 0, 0, 0x31, 0, 0, 0, 0, 0, 0, 0, 0}
+		require.NoError(t, dt.UnmarshalProto(wire))
+		assert.Equal(t, int64(1), dt.ProtoSeconds())
+	})
+
+	t.Run("should reject invalid messages", func(t *testing.T) {
+		var dt DateTime
+		for _, wire := range [][]byte{
+			{0x08},
+			{0x08, 0x80},
+			{0x10, 0x80, 0x94, 0xeb, 0xdc, 0x03},
+			{0x10, 0xff, 0xff, 0xff, 0xff, 0x0f},
+			{0x22, 0x05, 'h'},
+			{0x2d, 0},
+			{0x0b},
+		} {
+			require.Errorf(t, dt.UnmarshalProto(wire), "expected %x to be rejected", wire)
+		}
+	})
+}
+
+func BenchmarkParseDateTime(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseDateTime(string(testCases[i%len(testCases)].in))
+	}
+}
+
+func TestParseDateTimeStrict(t *testing.T) {
+	for _, tc := range []struct {
+		in       string
+		expected time.Time
+	}{
+		{"2014-12-15T08:00:00Z", time.Date(2014, 12, 15, 8, 0, 0, 0, time.UTC)},
+		{"2014-12-15T08:00:00.123Z", time.Date(2014, 12, 15, 8, 0, 0, 123000000, time.UTC)},
+		{"2014-12-15T08:00:00.123456789+02:00", time.Date(2014, 12, 15, 6, 0, 0, 123456789, time.UTC)},
+	} {
+		dt, err := ParseDateTimeStrict(tc.in)
+		require.NoError(t, err)
+		assert.True(t, tc.expected.Equal(time.Time(dt)), "unexpected date-time parsed from %s", tc.in)
+
+		dt, err = ParseDateTimeWithMode(tc.in, DateTimeParseStrict)
+		require.NoError(t, err)
+		assert.True(t, tc.expected.Equal(time.Time(dt)))
+	}
+
+	for _, in := range []string{
+		"",
+		"2014-12-15 08:00:00",
+		"2014-12-15T08:00:00",
+		"2014-12-15T08:00Z",
+		"2014-12-15",
+		"2014-12-15T08:00:00.000+0200",
+	} {
+		_, err := ParseDateTimeStrict(in)
+		var parseErr *DateTimeParseError
+		require.ErrorAsf(t, err, &parseErr, "expected %q to be rejected", in)
+		assert.Equal(t, in, parseErr.Value)
+		assert.Contains(t, err.Error(), "is not a RFC 3339 date-time")
+		assert.Error(t, errors.Unwrap(err))
+
+		// lenient parsing remains the default
+		_, err = ParseDateTimeWithMode(in, DateTimeParseMode(0))
+		require.NoError(t, err)
+	}
+
+	_, err := ParseDateTimeWithMode("2014-12-15T08:00:00Z", DateTimeParseMode(42))
+	require.Error(t, err)
+}
+
+func TestDateTime_EqualPrecision(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	base := DateTime(time.Date(2024, 1, 15, 10, 30, 45, 0, time.UTC))
+	sameMinute := DateTime(time.Date(2024, 1, 15, 5, 30, 5, 0, newYork))
+	sameHour := DateTime(time.Date(2024, 1, 15, 16, 29, 0, 0, kolkata)) // 10:59 UTC
+	sameDay := DateTime(time.Date(2024, 1, 15, 23, 59, 59, 0, time.UTC))
+	nextDay := DateTime(time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC))
+
+	assert.True(t, base.EqualMinute(sameMinute))
+	assert.True(t, base.EqualHour(sameMinute))
+	assert.True(t, base.EqualDay(sameMinute))
+
+	assert.False(t, base.EqualMinute(sameHour))
+	assert.True(t, base.EqualHour(sameHour))
+	assert.True(t, base.EqualDay(sameHour))
+
+	assert.False(t, base.EqualHour(sameDay))
+	assert.True(t, base.EqualDay(sameDay))
+
+	assert.False(t, base.EqualDay(nextDay))
+	assert.False(t, sameDay.EqualDay(nextDay))
+
+	// 2024-01-15T23:59:59Z and 2024-01-16T00:00:00Z are both on January 15 in New York
+	assert.True(t, sameDay.SameDayAs(nextDay, newYork))
+	assert.False(t, sameDay.SameDayAs(nextDay, nil))
+	assert.False(t, base.SameDayAs(sameDay, kolkata))
+	assert.True(t, base.SameDayAs(sameDay, newYork))
+}
+
+func TestDateTime_MarshalJSONZeroAsNull(t *testing.T) {
+	t.Cleanup(func() { DateTimeMarshalZeroAsNull = false })
+	dt := DateTime(time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC))
+
+	b, err := json.Marshal(DateTime{})
+	require.NoError(t, err)
+	assert.Equal(t, `"0001-01-01T00:00:00.000Z"`, string(b))
+
+	b, err = DateTime{}.MarshalJSONOrNull()
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = dt.MarshalJSONOrNull()
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-01-15T10:30:00.000Z"`, string(b))
+
+	DateTimeMarshalZeroAsNull = true
+	b, err = json.Marshal(DateTime{})
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+
+	b, err = json.Marshal(dt)
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-01-15T10:30:00.000Z"`, string(b))
+}
+
+func TestDateTime_StringLocation(t *testing.T) {
+	defaultNormalize := NormalizeTimeForMarshal
+	t.Cleanup(func() { NormalizeTimeForMarshal = defaultNormalize })
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	dt := DateTime(time.Date(2024, time.January, 15, 10, 30, 0, 0, paris))
+
+	assert.Equal(t, "2024-01-15T10:30:00.000+01:00", dt.StringLocal())
+	assert.Equal(t, "2024-01-15T09:30:00.000Z", dt.StringUTC())
+	assert.Equal(t, dt.StringLocal(), dt.String())
+
+	NormalizeTimeForMarshal = time.Time.UTC
+	assert.Equal(t, "2024-01-15T10:30:00.000+01:00", dt.String(), "String should not be normalized")
+
+	text, err := dt.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-15T09:30:00.000Z", string(text))
+
+	b, err := json.Marshal(dt)
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-01-15T09:30:00.000Z"`, string(b))
+
+	value, err := dt.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-15T09:30:00.000Z", value)
+}
+
+func TestDateTime_GoString(t *testing.T) {
+	dt := DateTime(time.Date(2024, time.January, 15, 10, 30, 0, 500, time.UTC))
+	assert.Equal(t, "strfmt.DateTime(time.Date(2024, time.January, 15, 10, 30, 0, 500, time.UTC))", dt.GoString())
+	assert.Equal(t, dt.GoString(), fmt.Sprintf("%#v", dt))
+}
@@ -0,0 +1,177 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func init() {
+	tz := TimeZone("")
+	// register this format in the default registry
+	addBuiltin("timezone", &tz, IsTimeZone)
+}
+
+// IsTimeZone returns true when the string is a time zone name known to time.LoadLocation,
+// such as an IANA time zone (e.g. "America/New_York") or "UTC".
+//
+// The empty string and "Local", which time.LoadLocation resolves to UTC and to the local time zone
+// of the host, are not time zone names and are rejected.
+//
+// Validation relies on the time zone database of the host. Programs which must validate time zones
+// consistently across hosts should import the embedded database with:
+//
+//	import _ "time/tzdata"
+func IsTimeZone(str string) bool {
+	_, err := TimeZone(str).Location()
+	return err == nil
+}
+
+// TimeZone represents a time zone name from the IANA time zone database (e.g. "Europe/Paris")
+//
+// Validation depends on the time zone database available on the host: see IsTimeZone.
+//
+// swagger:strfmt timezone
+type TimeZone string
+
+// Location loads the time.Location for this time zone.
+//
+// Like IsTimeZone, the empty string and "Local" are rejected.
+func (z TimeZone) Location() (*time.Location, error) {
+	if z == "" || z == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", string(z))
+	}
+	return time.LoadLocation(string(z))
+}
+
+// OffsetAt returns the offset to UTC in seconds of this time zone at the given time.
+//
+// The returned boolean is false when the time zone is not known.
+func (z TimeZone) OffsetAt(t time.Time) (int, bool) {
+	loc, err := z.Location()
+	if err != nil {
+		return 0, false
+	}
+	_, offset := t.In(loc).Zone()
+	return offset, true
+}
+
+// MarshalText turns this instance into text
+func (z TimeZone) MarshalText() ([]byte, error) {
+	return []byte(string(z)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (z *TimeZone) UnmarshalText(data []byte) error { // validation is performed later on
+	*z = TimeZone(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (z *TimeZone) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*z = TimeZone(string(v))
+	case string:
+		*z = TimeZone(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.TimeZone from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (z TimeZone) Value() (driver.Value, error) {
+	return driver.Value(string(z)), nil
+}
+
+func (z TimeZone) String() string {
+	return string(z)
+}
+
+// MarshalJSON returns the TimeZone as JSON
+func (z TimeZone) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(z))
+}
+
+// UnmarshalJSON sets the TimeZone from JSON
+func (z *TimeZone) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var zstr string
+	if err := json.Unmarshal(data, &zstr); err != nil {
+		return err
+	}
+	*z = TimeZone(zstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (z TimeZone) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": z.String()})
+}
+
+// UnmarshalBSON document into this value
+func (z *TimeZone) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(string); ok {
+		*z = TimeZone(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as TimeZone")
+}
+
+// MarshalBSONValue marshals this TimeZone as a BSON string
+func (z TimeZone) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalBSONString(string(z))
+}
+
+// UnmarshalBSONValue reads this TimeZone from a BSON string
+func (z *TimeZone) UnmarshalBSONValue(tpe bsontype.Type, data []byte) error {
+	str, err := unmarshalBSONString(tpe, data, "TimeZone")
+	if err != nil {
+		return err
+	}
+	*z = TimeZone(str)
+	return nil
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (z *TimeZone) DeepCopyInto(out *TimeZone) {
+	*out = *z
+}
+
+// DeepCopy copies the receiver into a new TimeZone.
+func (z *TimeZone) DeepCopy() *TimeZone {
+	if z == nil {
+		return nil
+	}
+	out := new(TimeZone)
+	z.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,106 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // tests must not depend on the time zone database of the host
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTimeZone(t *testing.T) {
+	tz := TimeZone("America/New_York")
+	str := "Europe/Paris"
+	validTimeZones := []string{
+		"UTC",
+		"America/New_York",
+		"Europe/Paris",
+		"Asia/Kolkata",
+		"Australia/Lord_Howe",
+		"Etc/GMT+5",
+	}
+	invalidTimeZones := []string{
+		"America/Nowhere",
+		"Mars/Olympus_Mons",
+		"utc/",
+		"../etc/passwd",
+		"/etc/localtime",
+		"+05:00",
+		"",
+		"Local",
+	}
+
+	testStringFormat(t, &tz, "timezone", str, validTimeZones, invalidTimeZones)
+}
+
+func TestTimeZone_Location(t *testing.T) {
+	loc, err := TimeZone("Europe/Paris").Location()
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", loc.String())
+
+	_, err = TimeZone("Europe/Nowhere").Location()
+	require.Error(t, err)
+
+	for _, tz := range []TimeZone{"", "Local"} {
+		_, err = tz.Location()
+		require.Errorf(t, err, "expected %q to be rejected", tz)
+		assert.Falsef(t, IsTimeZone(string(tz)), "expected %q to be rejected", tz)
+	}
+}
+
+func TestTimeZone_OffsetAt(t *testing.T) {
+	winter := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		tz       TimeZone
+		at       time.Time
+		expected int
+	}{
+		{"UTC", winter, 0},
+		{"Europe/Paris", winter, 3600},
+		{"Europe/Paris", summer, 7200},
+		{"America/New_York", winter, -5 * 3600},
+		{"America/New_York", summer, -4 * 3600},
+		{"Asia/Kolkata", summer, 5*3600 + 1800},
+	} {
+		offset, ok := tc.tz.OffsetAt(tc.at)
+		assert.Truef(t, ok, "expected %q to be a known time zone", tc.tz)
+		assert.Equalf(t, tc.expected, offset, "unexpected offset for %q at %v", tc.tz, tc.at)
+	}
+
+	offset, ok := TimeZone("Europe/Nowhere").OffsetAt(winter)
+	assert.False(t, ok)
+	assert.Zero(t, offset)
+}
+
+func TestDeepCopyTimeZone(t *testing.T) {
+	tz := TimeZone("Europe/Paris")
+	in := &tz
+
+	out := new(TimeZone)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *TimeZone
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
@@ -1,14 +1,17 @@
 package strfmt
 
 import (
+	"bytes"
 	cryptorand "crypto/rand"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/oklog/ulid"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -62,13 +65,19 @@ var (
 
 	// ULIDValueOverrideFunc allows you to override the Value method of the ULID type
 	ULIDValueOverrideFunc = ULIDValueDefaultFunc
+
+	// ULIDAcceptNumericJSON makes ULID.UnmarshalJSON accept JSON numbers as well as strings.
+	// Numbers are read as unix timestamps in milliseconds, as with ULIDFromMillis.
+	//
+	// It defaults to false: ULIDs are always marshaled as strings, so numbers can only come from other sources.
+	ULIDAcceptNumericJSON = false
 )
 
 func init() {
 	// register formats in the default registry:
 	//   - ulid
 	ulid := ULID{}
-	Default.Add("ulid", &ulid, IsULID)
+	addBuiltin("ulid", &ulid, IsULID)
 }
 
 // IsULID checks if provided string is ULID format
@@ -91,6 +100,20 @@ func NewULIDZero() ULID {
 	return ULID{}
 }
 
+// ULIDFromMillis returns the ULID with the given unix timestamp in milliseconds and zero random bits.
+//
+// An error is returned when the timestamp is negative or greater than the maximum timestamp of a ULID.
+func ULIDFromMillis(ms int64) (ULID, error) {
+	var u ULID
+	if ms < 0 {
+		return u, fmt.Errorf("cannot create a ULID from negative timestamp %d", ms)
+	}
+	if err := u.SetTime(uint64(ms)); err != nil {
+		return u, err
+	}
+	return u, nil
+}
+
 // NewULID generates new unique ULID value and a error if any
 func NewULID() (ULID, error) {
 	var u ULID
@@ -111,6 +134,46 @@ func NewULID() (ULID, error) {
 	return u, nil
 }
 
+// MonotonicULIDGenerator generates ULIDs which strictly increase within the same millisecond.
+//
+// It is safe for concurrent use.
+type MonotonicULIDGenerator struct {
+	mu      sync.Mutex
+	entropy io.Reader // as returned by ulid.Monotonic, which is not safe for concurrent use
+}
+
+// DefaultMonotonicGenerator is a monotonic ULID generator using crypto/rand as its entropy source
+var DefaultMonotonicGenerator = NewMonotonicULIDGenerator(nil)
+
+// NewMonotonicULIDGenerator creates a monotonic ULID generator drawing its entropy from r.
+//
+// When r is nil, crypto/rand is used.
+func NewMonotonicULIDGenerator(r io.Reader) *MonotonicULIDGenerator {
+	if r == nil {
+		r = cryptorand.Reader
+	}
+	return &MonotonicULIDGenerator{entropy: ulid.Monotonic(r, 0)}
+}
+
+// Next generates a new ULID, greater than all the ULIDs previously generated within the same millisecond
+func (g *MonotonicULIDGenerator) Next() (ULID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	id, err := ulid.New(ulid.Now(), g.entropy)
+	if err != nil {
+		return ULID{}, err
+	}
+	return ULID{ULID: id}, nil
+}
+
+// NewULIDMonotonic returns a function generating monotonically increasing ULIDs from the specified entropy source.
+//
+// The returned function is safe for concurrent use.
+func NewULIDMonotonic(entropy io.Reader) func() (ULID, error) {
+	return NewMonotonicULIDGenerator(entropy).Next
+}
+
 // GetULID returns underlying instance of ULID
 func (u *ULID) GetULID() interface{} {
 	return u.ULID
@@ -149,11 +212,16 @@ func (u ULID) MarshalJSON() ([]byte, error) {
 	return json.Marshal(u.String())
 }
 
-// UnmarshalJSON sets the ULID from JSON
+// UnmarshalJSON sets the ULID from JSON.
+//
+// JSON numbers are accepted as timestamps in milliseconds when ULIDAcceptNumericJSON is set.
 func (u *ULID) UnmarshalJSON(data []byte) error {
 	if string(data) == jsonNull {
 		return nil
 	}
+	if ULIDAcceptNumericJSON && len(data) > 0 && data[0] != '"' {
+		return u.unmarshalJSONNumber(data)
+	}
 	var ustr string
 	if err := json.Unmarshal(data, &ustr); err != nil {
 		return err
@@ -166,6 +234,23 @@ func (u *ULID) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (u *ULID) unmarshalJSONNumber(data []byte) error {
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return err
+	}
+	ms, err := num.Int64()
+	if err != nil {
+		return fmt.Errorf("couldn't parse JSON number as a ULID timestamp in milliseconds: %w", err)
+	}
+	id, err := ULIDFromMillis(ms)
+	if err != nil {
+		return err
+	}
+	*u = id
+	return nil
+}
+
 // MarshalBSON document from this value
 func (u ULID) MarshalBSON() ([]byte, error) {
 	return bson.Marshal(bson.M{"data": u.String()})
@@ -204,27 +289,85 @@ func (u *ULID) DeepCopy() *ULID {
 	return out
 }
 
+// ulidBinarySize is the size of the binary representation of a ULID
+const ulidBinarySize = 16
+
 // GobEncode implements the gob.GobEncoder interface.
+//
+// Like MarshalBinary, it encodes the 16 bytes of the ULID.
 func (u ULID) GobEncode() ([]byte, error) {
-	return u.ULID.MarshalBinary()
+	return u.MarshalBinary()
 }
 
 // GobDecode implements the gob.GobDecoder interface.
 func (u *ULID) GobDecode(data []byte) error {
-	return u.ULID.UnmarshalBinary(data)
+	return u.UnmarshalBinary(data)
 }
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The ULID is encoded as its 16 bytes, regardless of the encoding used by the underlying ulid library.
 func (u ULID) MarshalBinary() ([]byte, error) {
-	return u.ULID.MarshalBinary()
+	b := make([]byte, ulidBinarySize)
+	copy(b, u.ULID[:])
+	return b, nil
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
 func (u *ULID) UnmarshalBinary(data []byte) error {
-	return u.ULID.UnmarshalBinary(data)
+	if len(data) != ulidBinarySize {
+		return fmt.Errorf("cannot unmarshal strfmt.ULID from %d bytes: expected %d bytes", len(data), ulidBinarySize)
+	}
+	copy(u.ULID[:], data)
+	return nil
 }
 
 // Equal checks if two ULID instances are equal by their underlying type
 func (u ULID) Equal(other ULID) bool {
 	return u.ULID == other.ULID
 }
+
+// CompareULID returns -1, 0 or 1 when this ULID sorts respectively before, as or after another ULID.
+//
+// ULIDs are compared by their binary representation, which is chronological for ULIDs generated
+// in different milliseconds. The result is suitable for slices.SortFunc.
+//
+// CompareULID is the counterpart of the Compare method promoted from the embedded ulid.ULID,
+// which compares with an ulid.ULID rather than with a ULID.
+func (u ULID) CompareULID(other ULID) int {
+	return bytes.Compare(u.ULID[:], other.ULID[:])
+}
+
+// ULIDLess returns true when a sorts before b, e.g. for sort.Slice
+func ULIDLess(a, b ULID) bool {
+	return a.CompareULID(b) < 0
+}
+
+// SortULIDs sorts a slice of ULIDs in ascending order
+func SortULIDs(s []ULID) {
+	sort.Slice(s, func(i, j int) bool { return ULIDLess(s[i], s[j]) })
+}
+
+// SearchULIDs searches for a ULID in a sorted slice of ULIDs, and returns the index where it is found,
+// or the index where it would be inserted when it is not present, like sort.Search
+func SearchULIDs(s []ULID, target ULID) int {
+	return sort.Search(len(s), func(i int) bool { return s[i].CompareULID(target) >= 0 })
+}
+
+// ToUUID converts this ULID to a UUID with the same 16 bytes, in the standard hyphenated form.
+//
+// The version and variant bits are left untouched: the resulting UUID usually has no valid version.
+func (u ULID) ToUUID() (UUID, error) {
+	return UUID(uuid.UUID(u.ULID).String()), nil
+}
+
+// ToULID converts this UUID to a ULID with the same 16 bytes.
+//
+// The conversion round-trips with ULID.ToUUID, with the UUID rendered in lower case.
+func (u UUID) ToULID() (ULID, error) {
+	id, err := uuid.Parse(string(u))
+	if err != nil {
+		return ULID{}, fmt.Errorf("invalid UUID %q: %w", string(u), err)
+	}
+	return ULID{ULID: ulid.ULID(id)}, nil
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"testing"
 
@@ -342,3 +344,239 @@ func TestIsULID(t *testing.T) {
 	}
 
 }
+
+func TestFormatULID_UUIDConversion(t *testing.T) {
+	for _, tc := range []struct {
+		ulid string
+		uuid UUID
+	}{
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01563e3a-b5d3-d676-4c61-efb99302bd5b"},
+		{"01HGW2N7EHJVKV0YBKX6B0PZ4M", "018c382a-9dd1-96e7-b079-73e9960b7c94"},
+		{"00000000000000000000000000", "00000000-0000-0000-0000-000000000000"},
+		{"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+	} {
+		u, err := ParseULID(tc.ulid)
+		require.NoError(t, err)
+
+		id, err := u.ToUUID()
+		require.NoError(t, err)
+		assert.Equal(t, tc.uuid, id)
+
+		back, err := tc.uuid.ToULID()
+		require.NoError(t, err)
+		assert.Equal(t, tc.ulid, back.String())
+	}
+
+	t.Run("UUID case is normalized", func(t *testing.T) {
+		u, err := UUID("01563E3A-B5D3-D676-4C61-EFB99302BD5B").ToULID()
+		require.NoError(t, err)
+		assert.Equal(t, "01ARZ3NDEKTSV4RRFFQ69G5FAV", u.String())
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		_, err := UUID("not a uuid").ToULID()
+		require.Error(t, err)
+	})
+}
+
+func TestFormatULID_Monotonic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should generate increasing ULIDs", func(t *testing.T) {
+		next := NewULIDMonotonic(nil)
+		prev, err := next()
+		require.NoError(t, err)
+		for i := 0; i < 1000; i++ {
+			id, err := next()
+			require.NoError(t, err)
+			require.Equal(t, -1, prev.CompareULID(id))
+			prev = id
+		}
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		const workers, count = 8, 100
+		gen := NewMonotonicULIDGenerator(nil)
+		ids := make(chan ULID, workers*count)
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < count; i++ {
+					id, err := gen.Next()
+					assert.NoError(t, err)
+					ids <- id
+				}
+			}()
+		}
+		wg.Wait()
+		close(ids)
+
+		seen := make(map[ULID]struct{}, workers*count)
+		for id := range ids {
+			seen[id] = struct{}{}
+		}
+		assert.Len(t, seen, workers*count)
+	})
+
+	t.Run("should fail when entropy is exhausted", func(t *testing.T) {
+		next := NewULIDMonotonic(bytes.NewReader(nil))
+		_, err := next()
+		require.Error(t, err)
+	})
+
+	t.Run("should generate with the default generator", func(t *testing.T) {
+		id, err := DefaultMonotonicGenerator.Next()
+		require.NoError(t, err)
+		assert.True(t, IsULID(id.String()))
+	})
+}
+
+func BenchmarkNewULID(b *testing.B) {
+	b.Run("random", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = NewULID()
+			}
+		})
+	})
+
+	b.Run("monotonic", func(b *testing.B) {
+		gen := NewMonotonicULIDGenerator(nil)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_, _ = gen.Next()
+			}
+		})
+	})
+}
+
+func TestFormatULID_BinaryEncoding(t *testing.T) {
+	id, err := ParseULID(testUlid)
+	require.NoError(t, err)
+
+	b, err := id.MarshalBinary()
+	require.NoError(t, err)
+	require.Len(t, b, 16)
+	assert.Equal(t, id.ULID[:], b)
+
+	// the encoded bytes do not alias the ULID
+	b[0] = ^b[0]
+	assert.NotEqual(t, id.ULID[0], b[0])
+	b[0] = ^b[0]
+
+	var result ULID
+	require.NoError(t, result.UnmarshalBinary(b))
+	assert.Equal(t, id, result)
+
+	g, err := id.GobEncode()
+	require.NoError(t, err)
+	assert.Equal(t, b, g)
+	result = NewULIDZero()
+	require.NoError(t, result.GobDecode(g))
+	assert.Equal(t, id, result)
+
+	require.Error(t, result.UnmarshalBinary(b[:15]))
+	require.Error(t, result.UnmarshalBinary(append(b, 0)))
+	require.Error(t, result.GobDecode(nil))
+	assert.Equal(t, id, result)
+}
+
+func TestULID_CompareULID(t *testing.T) {
+	first, err := ParseULID("01EYXZVGBHG26MFTG4JWR4K558")
+	require.NoError(t, err)
+	second, err := ParseULID("01EYXZVGBHG26MFTG4JWR4K559")
+	require.NoError(t, err)
+	third, err := ParseULID("01EYY00000000000000000000A")
+	require.NoError(t, err)
+
+	assert.Equal(t, -1, first.CompareULID(second))
+	assert.Equal(t, 1, third.CompareULID(second))
+	assert.Equal(t, 0, first.CompareULID(first))
+
+	// the method of the embedded ulid.ULID is still available
+	assert.Equal(t, -1, first.Compare(second.ULID))
+	assert.Equal(t, 0, first.Compare(first.ULID))
+	assert.True(t, ULIDLess(first, third))
+	assert.False(t, ULIDLess(third, first))
+	assert.False(t, ULIDLess(first, first))
+
+	ids := []ULID{third, first, NewULIDZero(), second}
+	SortULIDs(ids)
+	assert.Equal(t, []ULID{NewULIDZero(), first, second, third}, ids)
+
+	assert.Equal(t, 1, SearchULIDs(ids, first))
+	assert.Equal(t, 3, SearchULIDs(ids, third))
+	missing, err := ParseULID("01EYXZVGBHG26MFTG4JWR4K55A")
+	require.NoError(t, err)
+	assert.Equal(t, 3, SearchULIDs(ids, missing))
+	assert.Equal(t, 0, SearchULIDs(nil, first))
+}
+
+func BenchmarkSortULIDs(b *testing.B) {
+	const size = 10000
+	ids := make([]ULID, size)
+	for i := range ids {
+		id, err := NewULID()
+		require.NoError(b, err)
+		ids[size-1-i] = id
+	}
+	work := make([]ULID, size)
+
+	b.Run("SortULIDs", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			copy(work, ids)
+			SortULIDs(work)
+		}
+	})
+
+	b.Run("sort by string", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			copy(work, ids)
+			sort.Slice(work, func(i, j int) bool { return work[i].String() < work[j].String() })
+		}
+	})
+}
+
+func TestULIDFromMillis(t *testing.T) {
+	const ms = int64(1469918176385)
+	id, err := ULIDFromMillis(ms)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(ms), id.Time())
+	assert.Equal(t, "01ARYZ6S41", id.String()[:10])
+	assert.Equal(t, "0000000000000000", id.String()[10:])
+
+	zero, err := ULIDFromMillis(0)
+	require.NoError(t, err)
+	assert.Equal(t, NewULIDZero(), zero)
+
+	_, err = ULIDFromMillis(-1)
+	require.Error(t, err)
+
+	_, err = ULIDFromMillis(1 << 48)
+	require.Error(t, err)
+}
+
+func TestULID_UnmarshalJSONNumber(t *testing.T) {
+	t.Cleanup(func() { ULIDAcceptNumericJSON = false })
+
+	var id ULID
+	require.Error(t, json.Unmarshal([]byte("1469918176385"), &id))
+
+	ULIDAcceptNumericJSON = true
+	require.NoError(t, json.Unmarshal([]byte("1469918176385"), &id))
+	expected, err := ULIDFromMillis(1469918176385)
+	require.NoError(t, err)
+	assert.Equal(t, expected, id)
+
+	var fromString ULID
+	require.NoError(t, json.Unmarshal([]byte(`"`+testUlid+`"`), &fromString))
+	assert.Equal(t, testUlid, fromString.String())
+
+	for _, invalid := range []string{"-1", "1.5", "1e20", "281474976710656"} {
+		require.Errorf(t, json.Unmarshal([]byte(invalid), &id), "expected %s to be rejected", invalid)
+	}
+}
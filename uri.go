@@ -0,0 +1,236 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Parse parses this URI into a *url.URL
+func (u URI) Parse() (*url.URL, error) {
+	return url.Parse(string(u))
+}
+
+// Scheme returns the scheme of this URI, or an empty string if it cannot be parsed
+func (u URI) Scheme() string {
+	pu, err := u.Parse()
+	if err != nil {
+		return ""
+	}
+	return pu.Scheme
+}
+
+// Host returns the host (host or host:port) of this URI, or an empty string if it cannot be parsed
+func (u URI) Host() string {
+	pu, err := u.Parse()
+	if err != nil {
+		return ""
+	}
+	return pu.Host
+}
+
+// Path returns the unescaped path of this URI, or an empty string if it cannot be parsed
+func (u URI) Path() string {
+	pu, err := u.Parse()
+	if err != nil {
+		return ""
+	}
+	return pu.Path
+}
+
+// RawQuery returns the encoded query of this URI (without '?'), or an empty string if it cannot be parsed
+func (u URI) RawQuery() string {
+	pu, err := u.Parse()
+	if err != nil {
+		return ""
+	}
+	return pu.RawQuery
+}
+
+// Fragment returns the unescaped fragment of this URI (without '#'), or an empty string if it cannot be parsed
+func (u URI) Fragment() string {
+	pu, err := u.Parse()
+	if err != nil {
+		return ""
+	}
+	return pu.Fragment
+}
+
+// IsAbsolute returns true when this URI can be parsed and has a scheme
+func (u URI) IsAbsolute() bool {
+	pu, err := u.Parse()
+	return err == nil && pu.IsAbs()
+}
+
+// ResolveReference resolves a (possibly relative) URI reference against this URI, as specified by RFC 3986, section 5.2
+func (u URI) ResolveReference(ref URI) (URI, error) {
+	base, err := u.Parse()
+	if err != nil {
+		return "", err
+	}
+	pref, err := ref.Parse()
+	if err != nil {
+		return "", err
+	}
+	return URI(base.ResolveReference(pref).String()), nil
+}
+
+// Normalize returns a normalized version of this URI:
+//   - scheme and host are lowercased
+//   - default ports are removed (80 for http, 443 for https)
+//   - "." and ".." path segments are resolved
+//   - reserved characters in the path are percent-encoded
+func (u URI) Normalize() (URI, error) {
+	pu, err := u.Parse()
+	if err != nil {
+		return "", err
+	}
+
+	pu.Scheme = strings.ToLower(pu.Scheme)
+	pu.Host = strings.ToLower(pu.Host)
+	if port := pu.Port(); (pu.Scheme == "http" && port == "80") || (pu.Scheme == "https" && port == "443") {
+		pu.Host = strings.TrimSuffix(pu.Host, ":"+port)
+	}
+
+	if pu.Path != "" {
+		pu.Path = removeDotSegments(pu.Path)
+		pu.RawPath = ""
+	}
+
+	return URI(pu.String()), nil
+}
+
+// removeDotSegments resolves "." and ".." segments in a path, as specified by RFC 3986, section 5.2.4
+func removeDotSegments(pth string) string {
+	abs := strings.HasPrefix(pth, "/")
+	segments := strings.Split(strings.TrimPrefix(pth, "/"), "/")
+	out := make([]string, 0, len(segments))
+	for i, seg := range segments {
+		last := i == len(segments)-1
+		switch seg {
+		case ".":
+			if last {
+				out = append(out, "")
+			}
+		case "..":
+			if len(out) > 0 {
+				out = out[:len(out)-1]
+			}
+			if last {
+				out = append(out, "")
+			}
+		default:
+			out = append(out, seg)
+		}
+	}
+
+	res := strings.Join(out, "/")
+	if abs {
+		return "/" + res
+	}
+	return res
+}
+
+// IsSafe returns true when this URI has a scheme listed in allowedSchemes, e.g. to reject
+// "javascript:" or "data:" URIs in redirect locations. Schemes are compared case-insensitively.
+//
+// Relative references have no scheme and are never considered safe.
+// An error is returned when the URI cannot be parsed.
+func (u URI) IsSafe(allowedSchemes []string) (bool, error) {
+	parsed, err := u.Parse()
+	if err != nil {
+		return false, err
+	}
+	if parsed.Scheme == "" {
+		return false, nil
+	}
+	for _, scheme := range allowedSchemes {
+		if strings.EqualFold(parsed.Scheme, scheme) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsHTTP returns true when this URI is a valid http or https URI
+func (u URI) IsHTTP() bool {
+	ok, err := u.IsSafe([]string{"http", "https"})
+	return ok && err == nil
+}
+
+// SafeURIValidator returns a validator for URIs restricted to the allowed schemes.
+//
+// It may be used to register context-specific URI formats alongside "uri":
+//
+//	strfmt.Default.Add("avatar-url", new(strfmt.URI), strfmt.SafeURIValidator([]string{"https"}))
+func SafeURIValidator(allowedSchemes []string) Validator {
+	schemes := append([]string(nil), allowedSchemes...)
+	return func(str string) bool {
+		ok, err := URI(str).IsSafe(schemes)
+		return ok && err == nil
+	}
+}
+
+// QueryParams returns the parsed query parameters of this URI
+func (u URI) QueryParams() (url.Values, error) {
+	pu, err := u.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return url.ParseQuery(pu.RawQuery)
+}
+
+// WithQueryParam returns a copy of this URI with the query parameter k set to v,
+// replacing any value already set for k.
+//
+// The query is re-encoded, with parameters sorted by key.
+func (u URI) WithQueryParam(k, v string) (URI, error) {
+	return u.withQuery(func(q url.Values) { q.Set(k, v) })
+}
+
+// WithoutQueryParam returns a copy of this URI without the query parameter k.
+//
+// The query is re-encoded, with parameters sorted by key.
+func (u URI) WithoutQueryParam(k string) (URI, error) {
+	return u.withQuery(func(q url.Values) { q.Del(k) })
+}
+
+func (u URI) withQuery(update func(url.Values)) (URI, error) {
+	pu, err := u.Parse()
+	if err != nil {
+		return "", err
+	}
+	q, err := url.ParseQuery(pu.RawQuery)
+	if err != nil {
+		return "", err
+	}
+	update(q)
+	pu.RawQuery = q.Encode()
+	return URI(pu.String()), nil
+}
+
+// WithFragment returns a copy of this URI with its fragment replaced by the (unescaped) fragment.
+//
+// An empty fragment removes the fragment of this URI.
+func (u URI) WithFragment(fragment string) (URI, error) {
+	pu, err := u.Parse()
+	if err != nil {
+		return "", err
+	}
+	pu.Fragment = fragment
+	pu.RawFragment = ""
+	return URI(pu.String()), nil
+}
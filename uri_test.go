@@ -0,0 +1,215 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURI_Components(t *testing.T) {
+	uri := URI("https://user@example.com:8443/a/b%20c?x=1&y=2#frag")
+
+	pu, err := uri.Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", pu.Hostname())
+
+	assert.Equal(t, "https", uri.Scheme())
+	assert.Equal(t, "example.com:8443", uri.Host())
+	assert.Equal(t, "/a/b c", uri.Path())
+	assert.Equal(t, "x=1&y=2", uri.RawQuery())
+	assert.Equal(t, "frag", uri.Fragment())
+	assert.True(t, uri.IsAbsolute())
+
+	assert.False(t, URI("/relative/path").IsAbsolute())
+
+	invalid := URI("http://[::1")
+	_, err = invalid.Parse()
+	require.Error(t, err)
+	assert.Empty(t, invalid.Scheme())
+	assert.Empty(t, invalid.Host())
+	assert.Empty(t, invalid.Path())
+	assert.Empty(t, invalid.RawQuery())
+	assert.Empty(t, invalid.Fragment())
+	assert.False(t, invalid.IsAbsolute())
+}
+
+func TestURI_Normalize(t *testing.T) {
+	for _, tc := range []struct {
+		in       URI
+		expected URI
+	}{
+		{in: "HTTP://Example.COM:80/a/./b/../c", expected: "http://example.com/a/c"},
+		{in: "https://example.com:443/", expected: "https://example.com/"},
+		{in: "https://example.com:80/", expected: "https://example.com:80/"},
+		{in: "http://example.com:8080/a/b/..", expected: "http://example.com:8080/a/"},
+		{in: "http://example.com/a b", expected: "http://example.com/a%20b"},
+		{in: "http://example.com/../a?q=1#f", expected: "http://example.com/a?q=1#f"},
+		{in: "mailto:someone@example.com", expected: "mailto:someone@example.com"},
+	} {
+		normalized, err := tc.in.Normalize()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, normalized)
+	}
+
+	_, err := URI("http://[::1").Normalize()
+	require.Error(t, err)
+}
+
+func TestURI_ResolveReference(t *testing.T) {
+	base := URI("http://a/b/c/d;p?q")
+
+	for _, tc := range []struct {
+		ref      URI
+		expected URI
+	}{
+		{ref: "g", expected: "http://a/b/c/g"},
+		{ref: "../g", expected: "http://a/b/g"},
+		{ref: "/g", expected: "http://a/g"},
+		{ref: "?y", expected: "http://a/b/c/d;p?y"},
+		{ref: "#s", expected: "http://a/b/c/d;p?q#s"},
+		{ref: "https://other/x", expected: "https://other/x"},
+	} {
+		resolved, err := base.ResolveReference(tc.ref)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, resolved)
+	}
+
+	_, err := base.ResolveReference("http://[::1")
+	require.Error(t, err)
+	_, err = URI("http://[::1").ResolveReference("g")
+	require.Error(t, err)
+}
+
+func TestURI_IsSafe(t *testing.T) {
+	allowed := []string{"http", "https", "mailto"}
+
+	for _, safe := range []URI{
+		"http://example.com",
+		"HTTPS://example.com/path?q=1",
+		"mailto:someone@example.com",
+	} {
+		ok, err := safe.IsSafe(allowed)
+		require.NoError(t, err)
+		assert.Truef(t, ok, "expected %s to be safe", safe)
+	}
+
+	for _, unsafe := range []URI{
+		"javascript:alert(1)",
+		"JavaScript:alert(1)",
+		"data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==",
+		"ftp://example.com/file",
+		"//example.com/path",
+		"/relative/path",
+		"",
+	} {
+		ok, err := unsafe.IsSafe(allowed)
+		require.NoError(t, err)
+		assert.Falsef(t, ok, "expected %s not to be safe", unsafe)
+	}
+
+	ok, err := URI("http://example.com/%zz").IsSafe(allowed)
+	require.Error(t, err)
+	assert.False(t, ok)
+
+	ok, err = URI("https://example.com").IsSafe(nil)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestURI_IsHTTP(t *testing.T) {
+	assert.True(t, URI("http://example.com").IsHTTP())
+	assert.True(t, URI("Https://example.com/a/b").IsHTTP())
+	assert.False(t, URI("javascript:alert(1)").IsHTTP())
+	assert.False(t, URI("ws://example.com").IsHTTP())
+	assert.False(t, URI("http://example.com/%zz").IsHTTP())
+}
+
+func TestSafeURIValidator(t *testing.T) {
+	registry := asFormatRegistry(t, NewFormats())
+	require.True(t, registry.Add("avatar-url", new(URI), SafeURIValidator([]string{"https"})))
+
+	assert.True(t, registry.Validates("avatar-url", "https://cdn.example.com/me.png"))
+	assert.False(t, registry.Validates("avatar-url", "http://cdn.example.com/me.png"))
+	assert.False(t, registry.Validates("avatar-url", "data:image/png;base64,iVBORw0KGgo="))
+	assert.True(t, registry.Validates("uri", "http://cdn.example.com/me.png"))
+}
+
+func TestURI_QueryParams(t *testing.T) {
+	u := URI("https://example.com/search?q=go+openapi&tag=a&tag=b#results")
+
+	params, err := u.QueryParams()
+	require.NoError(t, err)
+	assert.Equal(t, "go openapi", params.Get("q"))
+	assert.Equal(t, []string{"a", "b"}, params["tag"])
+
+	params, err = URI("https://example.com").QueryParams()
+	require.NoError(t, err)
+	assert.Empty(t, params)
+
+	_, err = URI("http://[::1").QueryParams()
+	require.Error(t, err)
+	_, err = URI("https://example.com/?q=%zz").QueryParams()
+	require.Error(t, err)
+}
+
+func TestURI_WithQueryParam(t *testing.T) {
+	u := URI("https://example.com/search?q=go&tag=a&tag=b#results")
+
+	replaced, err := u.WithQueryParam("tag", "c d")
+	require.NoError(t, err)
+	assert.Equal(t, URI("https://example.com/search?q=go&tag=c+d#results"), replaced)
+
+	added, err := URI("https://example.com/search").WithQueryParam("page", "2")
+	require.NoError(t, err)
+	assert.Equal(t, URI("https://example.com/search?page=2"), added)
+
+	removed, err := u.WithoutQueryParam("tag")
+	require.NoError(t, err)
+	assert.Equal(t, URI("https://example.com/search?q=go#results"), removed)
+
+	removed, err = removed.WithoutQueryParam("q")
+	require.NoError(t, err)
+	assert.Equal(t, URI("https://example.com/search#results"), removed)
+
+	unchanged, err := u.WithoutQueryParam("unknown")
+	require.NoError(t, err)
+	assert.Equal(t, u, unchanged)
+
+	for _, invalid := range []URI{"http://[::1", "https://example.com/?q=%zz"} {
+		_, err = invalid.WithQueryParam("k", "v")
+		require.Error(t, err)
+		_, err = invalid.WithoutQueryParam("k")
+		require.Error(t, err)
+	}
+}
+
+func TestURI_WithFragment(t *testing.T) {
+	u := URI("https://example.com/docs?v=1#intro")
+
+	withFragment, err := u.WithFragment("section 2")
+	require.NoError(t, err)
+	assert.Equal(t, URI("https://example.com/docs?v=1#section%202"), withFragment)
+	assert.Equal(t, "section 2", withFragment.Fragment())
+
+	withoutFragment, err := u.WithFragment("")
+	require.NoError(t, err)
+	assert.Equal(t, URI("https://example.com/docs?v=1"), withoutFragment)
+
+	_, err = URI("http://[::1").WithFragment("top")
+	require.Error(t, err)
+}
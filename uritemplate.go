@@ -0,0 +1,443 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	ut := URITemplate("")
+	// register this format in the default registry
+	addBuiltin("uri-template", &ut, IsURITemplate)
+}
+
+// IsURITemplate returns true when the string is a valid level 4 URI template, as specified by RFC 6570
+func IsURITemplate(str string) bool {
+	_, err := parseURITemplate(str)
+	return err == nil
+}
+
+// URITemplate represents a URI template, as specified by RFC 6570
+//
+// swagger:strfmt uri-template
+type URITemplate string
+
+// Variables returns the names of the variables referenced in this template, in order of appearance.
+//
+// Each name is returned only once. An invalid template has no variables.
+func (u URITemplate) Variables() []string {
+	parts, err := parseURITemplate(string(u))
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	seen := make(map[string]struct{})
+	for _, part := range parts {
+		for _, spec := range part.vars {
+			if _, ok := seen[spec.name]; ok {
+				continue
+			}
+			seen[spec.name] = struct{}{}
+			names = append(names, spec.name)
+		}
+	}
+	return names
+}
+
+// Expand expands this template with the provided variables, as specified by RFC 6570, section 3.
+//
+// Values may be strings, scalars (formatted with fmt), slices or arrays (lists),
+// or maps (associative arrays, expanded in the order of sorted keys).
+// Missing or nil values are undefined and skipped.
+func (u URITemplate) Expand(vars map[string]interface{}) (URI, error) {
+	parts, err := parseURITemplate(string(u))
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, part := range parts {
+		if part.op == nil {
+			b.WriteString(part.literal)
+			continue
+		}
+		if err := part.expand(&b, vars); err != nil {
+			return "", err
+		}
+	}
+	return URI(b.String()), nil
+}
+
+// MarshalText turns this instance into text
+func (u URITemplate) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *URITemplate) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = URITemplate(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *URITemplate) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = URITemplate(string(v))
+	case string:
+		*u = URITemplate(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.URITemplate from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u URITemplate) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u URITemplate) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the URITemplate as JSON
+func (u URITemplate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(u))
+}
+
+// UnmarshalJSON sets the URITemplate from JSON
+func (u *URITemplate) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var ustr string
+	if err := json.Unmarshal(data, &ustr); err != nil {
+		return err
+	}
+	*u = URITemplate(ustr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (u URITemplate) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": u.String()})
+}
+
+// UnmarshalBSON document into this value
+func (u *URITemplate) UnmarshalBSON(data []byte) error {
+	var m bson.M
+	if err := bson.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	if ud, ok := m["data"].(string); ok {
+		*u = URITemplate(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as URITemplate")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (u *URITemplate) DeepCopyInto(out *URITemplate) {
+	*out = *u
+}
+
+// DeepCopy copies the receiver into a new URITemplate.
+func (u *URITemplate) DeepCopy() *URITemplate {
+	if u == nil {
+		return nil
+	}
+	out := new(URITemplate)
+	u.DeepCopyInto(out)
+	return out
+}
+
+// uriTemplateOp describes the expansion behavior of an operator (RFC 6570, appendix A)
+type uriTemplateOp struct {
+	first         string
+	sep           string
+	named         bool
+	ifEmpty       string
+	allowReserved bool
+}
+
+var uriTemplateOps = map[byte]*uriTemplateOp{
+	0:   {first: "", sep: ","},
+	'+': {first: "", sep: ",", allowReserved: true},
+	'.': {first: ".", sep: "."},
+	'/': {first: "/", sep: "/"},
+	';': {first: ";", sep: ";", named: true},
+	'?': {first: "?", sep: "&", named: true, ifEmpty: "="},
+	'&': {first: "&", sep: "&", named: true, ifEmpty: "="},
+	'#': {first: "#", sep: ",", allowReserved: true},
+}
+
+type uriTemplateVarSpec struct {
+	name    string
+	prefix  int
+	explode bool
+}
+
+// uriTemplatePart is either a literal or an expression
+type uriTemplatePart struct {
+	literal string
+	op      *uriTemplateOp
+	vars    []uriTemplateVarSpec
+}
+
+func parseURITemplate(str string) ([]uriTemplatePart, error) {
+	var parts []uriTemplatePart
+	for len(str) > 0 {
+		start := strings.IndexAny(str, "{}")
+		if start < 0 {
+			start = len(str)
+		}
+		if start > 0 {
+			if err := checkURITemplateLiteral(str[:start]); err != nil {
+				return nil, err
+			}
+			parts = append(parts, uriTemplatePart{literal: str[:start]})
+			str = str[start:]
+			continue
+		}
+		if str[0] == '}' {
+			return nil, errors.New("uri template: unexpected '}'")
+		}
+
+		end := strings.IndexByte(str, '}')
+		if end < 0 {
+			return nil, errors.New("uri template: unclosed expression")
+		}
+		part, err := parseURITemplateExpression(str[1:end])
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+		str = str[end+1:]
+	}
+	return parts, nil
+}
+
+func checkURITemplateLiteral(literal string) error {
+	for i := 0; i < len(literal); i++ {
+		c := literal[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(literal) || !isHexDigit(literal[i+1]) || !isHexDigit(literal[i+2]) {
+				return fmt.Errorf("uri template: invalid percent-encoding in literal %q", literal)
+			}
+			i += 2
+		case c <= ' ', c == 0x7f, strings.IndexByte("\"'<>\\^`{|}", c) >= 0:
+			return fmt.Errorf("uri template: invalid character %q in literal", c)
+		}
+	}
+	return nil
+}
+
+func parseURITemplateExpression(expr string) (uriTemplatePart, error) {
+	if expr == "" {
+		return uriTemplatePart{}, errors.New("uri template: empty expression")
+	}
+
+	var opChar byte
+	if strings.IndexByte("+#./;?&", expr[0]) >= 0 {
+		opChar = expr[0]
+		expr = expr[1:]
+	} else if strings.IndexByte("=,!@|", expr[0]) >= 0 {
+		return uriTemplatePart{}, fmt.Errorf("uri template: reserved operator %q", expr[0])
+	}
+
+	part := uriTemplatePart{op: uriTemplateOps[opChar]}
+	for _, raw := range strings.Split(expr, ",") {
+		spec := uriTemplateVarSpec{name: raw}
+		if strings.HasSuffix(raw, "*") {
+			spec.name = strings.TrimSuffix(raw, "*")
+			spec.explode = true
+		} else if idx := strings.IndexByte(raw, ':'); idx >= 0 {
+			spec.name = raw[:idx]
+			length := raw[idx+1:]
+			n, err := strconv.Atoi(length)
+			if err != nil || length[0] == '0' || length[0] == '+' || n < 1 || n > 9999 {
+				return uriTemplatePart{}, fmt.Errorf("uri template: invalid prefix modifier %q", raw)
+			}
+			spec.prefix = n
+		}
+		if !isURITemplateVarName(spec.name) {
+			return uriTemplatePart{}, fmt.Errorf("uri template: invalid variable name %q", spec.name)
+		}
+		part.vars = append(part.vars, spec)
+	}
+	return part, nil
+}
+
+func isURITemplateVarName(name string) bool {
+	if name == "" || name[0] == '.' || name[len(name)-1] == '.' {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_':
+		case c == '.':
+			if name[i-1] == '.' {
+				return false
+			}
+		case c == '%':
+			if i+2 >= len(name) || !isHexDigit(name[i+1]) || !isHexDigit(name[i+2]) {
+				return false
+			}
+			i += 2
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func (p uriTemplatePart) expand(b *strings.Builder, vars map[string]interface{}) error {
+	op := p.op
+	first := true
+	for _, spec := range p.vars {
+		val, ok := vars[spec.name]
+		if !ok || val == nil {
+			continue
+		}
+
+		rv := reflect.ValueOf(val)
+		var expanded string
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			if spec.prefix > 0 {
+				return fmt.Errorf("uri template: prefix modifier is not applicable to list variable %q", spec.name)
+			}
+			if rv.Len() == 0 {
+				continue
+			}
+			items := make([]string, 0, rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				item := op.encode(fmt.Sprint(rv.Index(i).Interface()))
+				if spec.explode && op.named {
+					item = op.pair(spec.name, item)
+				}
+				items = append(items, item)
+			}
+			if spec.explode {
+				expanded = strings.Join(items, op.sep)
+			} else {
+				expanded = op.pair(spec.name, strings.Join(items, ","))
+			}
+		case reflect.Map:
+			if spec.prefix > 0 {
+				return fmt.Errorf("uri template: prefix modifier is not applicable to associative array variable %q", spec.name)
+			}
+			if rv.Len() == 0 {
+				continue
+			}
+			keys := make([]string, 0, rv.Len())
+			values := make(map[string]string, rv.Len())
+			for _, k := range rv.MapKeys() {
+				key := fmt.Sprint(k.Interface())
+				keys = append(keys, key)
+				values[key] = fmt.Sprint(rv.MapIndex(k).Interface())
+			}
+			sort.Strings(keys)
+			items := make([]string, 0, len(keys))
+			for _, key := range keys {
+				switch {
+				case spec.explode && op.named:
+					items = append(items, op.pair(op.encode(key), op.encode(values[key])))
+				case spec.explode:
+					items = append(items, op.encode(key)+"="+op.encode(values[key]))
+				default:
+					items = append(items, op.encode(key)+","+op.encode(values[key]))
+				}
+			}
+			if spec.explode {
+				expanded = strings.Join(items, op.sep)
+			} else {
+				expanded = op.pair(spec.name, strings.Join(items, ","))
+			}
+		default:
+			value := fmt.Sprint(val)
+			if spec.prefix > 0 {
+				if runes := []rune(value); len(runes) > spec.prefix {
+					value = string(runes[:spec.prefix])
+				}
+			}
+			expanded = op.pair(spec.name, op.encode(value))
+		}
+
+		if first {
+			b.WriteString(op.first)
+			first = false
+		} else {
+			b.WriteString(op.sep)
+		}
+		b.WriteString(expanded)
+	}
+	return nil
+}
+
+// pair renders a named value for named operators, or the bare value otherwise
+func (o *uriTemplateOp) pair(name, value string) string {
+	if !o.named {
+		return value
+	}
+	if value == "" {
+		return name + o.ifEmpty
+	}
+	return name + "=" + value
+}
+
+// encode percent-encodes a value: only unreserved characters are kept, unless the operator allows reserved characters
+func (o *uriTemplateOp) encode(value string) string {
+	const upperhex = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', strings.IndexByte("-._~", c) >= 0:
+			b.WriteByte(c)
+		case o.allowReserved && strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0:
+			b.WriteByte(c)
+		case o.allowReserved && c == '%' && i+2 < len(value) && isHexDigit(value[i+1]) && isHexDigit(value[i+2]):
+			b.WriteString(value[i : i+3])
+			i += 2
+		default:
+			b.WriteByte('%')
+			b.WriteByte(upperhex[c>>4])
+			b.WriteByte(upperhex[c&15])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,202 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatURITemplate(t *testing.T) {
+	tpl := URITemplate("http://example.com/{id}")
+	str := "http://example.com/users/{user}{?fields*}"
+	validTemplates := []string{
+		"",
+		"http://example.com/",
+		"{var}",
+		"{+path}/here",
+		"X{#var}",
+		"X{.list*}",
+		"{/var,x}/here",
+		"{;x,y,empty}",
+		"{?x,y,undef}",
+		"?fixed=yes{&x}",
+		"{var:3}",
+		"{keys*}",
+		"{foo.bar}",
+		"{foo%20bar}",
+		"%2Fpath",
+	}
+	invalidTemplates := []string{
+		"{var",
+		"var}",
+		"{}",
+		"{+}",
+		"{=var}",
+		"{|var}",
+		"{!var}",
+		"{var:0}",
+		"{var:10000}",
+		"{var:abc}",
+		"{var*:3}",
+		"{.var.}",
+		"{var..name}",
+		"{var-name}",
+		"{x,}",
+		"{{var}}",
+		"with space",
+		"100%",
+		"<tag>",
+	}
+
+	testStringFormat(t, &tpl, "uri-template", str, validTemplates, invalidTemplates)
+}
+
+func TestURITemplate_Variables(t *testing.T) {
+	assert.Equal(t, []string{"user", "fields", "var"}, URITemplate("/users/{user}{?fields*}{#var:3,user}").Variables())
+	assert.Nil(t, URITemplate("/static").Variables())
+	assert.Nil(t, URITemplate("{unclosed").Variables())
+}
+
+func TestURITemplate_Expand(t *testing.T) {
+	// examples from RFC 6570, section 3.2
+	vars := map[string]interface{}{
+		"count":      []string{"one", "two", "three"},
+		"dom":        []string{"example", "com"},
+		"dub":        "me/too",
+		"hello":      "Hello World!",
+		"half":       "50%",
+		"var":        "value",
+		"who":        "fred",
+		"base":       "http://example.com/home/",
+		"path":       "/foo/bar",
+		"list":       []string{"red", "green", "blue"},
+		"keys":       map[string]string{"semi": ";", "dot": ".", "comma": ","},
+		"v":          6,
+		"x":          1024,
+		"y":          768,
+		"empty":      "",
+		"empty_keys": map[string]string{},
+		"undef":      nil,
+	}
+
+	for _, tc := range []struct {
+		template URITemplate
+		expected URI
+	}{
+		{"{count}", "one,two,three"},
+		{"{count*}", "one,two,three"},
+		{"{/count}", "/one,two,three"},
+		{"{/count*}", "/one/two/three"},
+		{"{;count}", ";count=one,two,three"},
+		{"{;count*}", ";count=one;count=two;count=three"},
+		{"{?count}", "?count=one,two,three"},
+		{"{?count*}", "?count=one&count=two&count=three"},
+		{"{&count*}", "&count=one&count=two&count=three"},
+		{"{var}", "value"},
+		{"{hello}", "Hello%20World%21"},
+		{"{half}", "50%25"},
+		{"O{empty}X", "OX"},
+		{"O{undef}X", "OX"},
+		{"{x,y}", "1024,768"},
+		{"{x,hello,y}", "1024,Hello%20World%21,768"},
+		{"?{x,empty}", "?1024,"},
+		{"?{x,undef}", "?1024"},
+		{"{var:3}", "val"},
+		{"{var:30}", "value"},
+		{"{list}", "red,green,blue"},
+		{"{list*}", "red,green,blue"},
+		{"{keys}", "comma,%2C,dot,.,semi,%3B"},
+		{"{keys*}", "comma=%2C,dot=.,semi=%3B"},
+		{"{+var}", "value"},
+		{"{+hello}", "Hello%20World!"},
+		{"{+half}", "50%25"},
+		{"{base}index", "http%3A%2F%2Fexample.com%2Fhome%2Findex"},
+		{"{+base}index", "http://example.com/home/index"},
+		{"{+path}/here", "/foo/bar/here"},
+		{"here?ref={+path}", "here?ref=/foo/bar"},
+		{"{+path:6}/here", "/foo/b/here"},
+		{"{+keys*}", "comma=,,dot=.,semi=;"},
+		{"{#var}", "#value"},
+		{"{#hello}", "#Hello%20World!"},
+		{"{#path:6}/here", "#/foo/b/here"},
+		{"{#list*}", "#red,green,blue"},
+		{"{.who}", ".fred"},
+		{"{.who,who}", ".fred.fred"},
+		{"{.half,who}", ".50%25.fred"},
+		{"www{.dom*}", "www.example.com"},
+		{"X{.var:3}", "X.val"},
+		{"X{.empty}", "X."},
+		{"X{.undef}", "X"},
+		{"X{.list*}", "X.red.green.blue"},
+		{"X{.keys*}", "X.comma=%2C.dot=..semi=%3B"},
+		{"X{.empty_keys}", "X"},
+		{"{/who,who}", "/fred/fred"},
+		{"{/half,who}", "/50%25/fred"},
+		{"{/who,dub}", "/fred/me%2Ftoo"},
+		{"{/var,x}/here", "/value/1024/here"},
+		{"{/var:1,var}", "/v/value"},
+		{"{/list*,path:4}", "/red/green/blue/%2Ffoo"},
+		{"{/keys*}", "/comma=%2C/dot=./semi=%3B"},
+		{"{;who}", ";who=fred"},
+		{"{;half}", ";half=50%25"},
+		{"{;empty}", ";empty"},
+		{"{;v,empty,who}", ";v=6;empty;who=fred"},
+		{"{;v,bar,who}", ";v=6;who=fred"},
+		{"{;x,y,empty}", ";x=1024;y=768;empty"},
+		{"{;hello:5}", ";hello=Hello"},
+		{"{;list*}", ";list=red;list=green;list=blue"},
+		{"{;keys*}", ";comma=%2C;dot=.;semi=%3B"},
+		{"{?who}", "?who=fred"},
+		{"{?x,y,empty}", "?x=1024&y=768&empty="},
+		{"{?x,y,undef}", "?x=1024&y=768"},
+		{"{?var:3}", "?var=val"},
+		{"{?list}", "?list=red,green,blue"},
+		{"{?keys}", "?keys=comma,%2C,dot,.,semi,%3B"},
+		{"{?keys*}", "?comma=%2C&dot=.&semi=%3B"},
+		{"?fixed=yes{&x}", "?fixed=yes&x=1024"},
+		{"{&var:3}", "&var=val"},
+		{"{&list*}", "&list=red&list=green&list=blue"},
+	} {
+		expanded, err := tc.template.Expand(vars)
+		require.NoErrorf(t, err, "unexpected error expanding %q", tc.template)
+		assert.Equalf(t, tc.expected, expanded, "unexpected expansion of %q", tc.template)
+	}
+
+	_, err := URITemplate("{unclosed").Expand(vars)
+	require.Error(t, err)
+	_, err = URITemplate("{list:3}").Expand(vars)
+	require.Error(t, err)
+	_, err = URITemplate("{keys:3}").Expand(vars)
+	require.Error(t, err)
+}
+
+func TestDeepCopyURITemplate(t *testing.T) {
+	tpl := URITemplate("/users/{id}")
+	in := &tpl
+
+	out := new(URITemplate)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *URITemplate
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}
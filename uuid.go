@@ -0,0 +1,152 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// uuidBinarySize is the size of the binary representation of a UUID
+const uuidBinarySize = 16
+
+// uuidBytes parses a UUID string into its 16 bytes representation
+func uuidBytes(str string) ([16]byte, error) {
+	u, err := uuid.Parse(str)
+	if err != nil {
+		return [16]byte{}, err
+	}
+	return u, nil
+}
+
+// ToBytes returns the 16 bytes representation of this UUID
+func (u UUID) ToBytes() ([16]byte, error) {
+	return uuidBytes(string(u))
+}
+
+// UUIDFromBytes creates a UUID from its 16 bytes representation
+func UUIDFromBytes(b [16]byte) UUID {
+	return UUID(uuid.UUID(b).String())
+}
+
+// ToBase64 returns the 16 bytes of this UUID encoded as unpadded URL-safe base64, i.e. 22 characters.
+//
+// An empty string is returned when the UUID is not valid.
+func (u UUID) ToBase64() string {
+	b, err := u.ToBytes()
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:])
+}
+
+// UUIDFromBase64 decodes a UUID encoded by UUID.ToBase64
+func UUIDFromBase64(str string) (UUID, error) {
+	b, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return "", err
+	}
+	if len(b) != len(uuid.Nil) {
+		return "", fmt.Errorf("invalid base64 UUID length: %d bytes", len(b))
+	}
+	return UUIDFromBytes([16]byte(b)), nil
+}
+
+// ToBytes returns the 16 bytes representation of this UUID
+func (u UUID3) ToBytes() ([16]byte, error) {
+	return uuidBytes(string(u))
+}
+
+// UUID3FromBytes creates a UUID3 from its 16 bytes representation
+func UUID3FromBytes(b [16]byte) UUID3 {
+	return UUID3(uuid.UUID(b).String())
+}
+
+// ToBytes returns the 16 bytes representation of this UUID
+func (u UUID4) ToBytes() ([16]byte, error) {
+	return uuidBytes(string(u))
+}
+
+// UUID4FromBytes creates a UUID4 from its 16 bytes representation
+func UUID4FromBytes(b [16]byte) UUID4 {
+	return UUID4(uuid.UUID(b).String())
+}
+
+// ToBytes returns the 16 bytes representation of this UUID
+func (u UUID5) ToBytes() ([16]byte, error) {
+	return uuidBytes(string(u))
+}
+
+// UUID5FromBytes creates a UUID5 from its 16 bytes representation
+func UUID5FromBytes(b [16]byte) UUID5 {
+	return UUID5(uuid.UUID(b).String())
+}
+
+// ToBytes returns the 16 bytes representation of this UUID
+func (u UUID6) ToBytes() ([16]byte, error) {
+	return uuidBytes(string(u))
+}
+
+// UUID6FromBytes creates a UUID6 from its 16 bytes representation
+func UUID6FromBytes(b [16]byte) UUID6 {
+	return UUID6(uuid.UUID(b).String())
+}
+
+// Time returns the timestamp embedded in this UUID, with a 100ns precision
+func (u UUID6) Time() (time.Time, error) {
+	id, err := uuid.Parse(string(u))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if id.Version() != uuid.Version(6) {
+		return time.Time{}, fmt.Errorf("strfmt: %q is not a version 6 UUID", string(u))
+	}
+
+	// the 60 bits timestamp is split into time_high (32 bits), time_mid (16 bits),
+	// then time_low (12 bits, after the version)
+	ts := int64(binary.BigEndian.Uint32(id[0:4]))<<28 |
+		int64(binary.BigEndian.Uint16(id[4:6]))<<12 |
+		int64(binary.BigEndian.Uint16(id[6:8])&0x0fff)
+	sec, nsec := uuid.Time(ts).UnixTime()
+	return time.Unix(sec, nsec).UTC(), nil
+}
+
+// Less tells if this UUID sorts before another one, i.e. if it was generated earlier.
+//
+// Unlike the comparison of strings, the comparison ignores the case and the dashes of the UUIDs.
+// Invalid UUIDs are compared as strings.
+func (u UUID6) Less(other UUID6) bool {
+	a, errA := uuid.Parse(string(u))
+	b, errB := uuid.Parse(string(other))
+	if errA != nil || errB != nil {
+		return string(u) < string(other)
+	}
+	return bytes.Compare(a[:], b[:]) < 0
+}
+
+// ToBytes returns the 16 bytes representation of this UUID
+func (u UUID7) ToBytes() ([16]byte, error) {
+	return uuidBytes(string(u))
+}
+
+// UUID7FromBytes creates a UUID7 from its 16 bytes representation
+func UUID7FromBytes(b [16]byte) UUID7 {
+	return UUID7(uuid.UUID(b).String())
+}
@@ -0,0 +1,109 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// uuid7MaxSeq is the largest counter value held by the 12 bits of the rand_a field
+	uuid7MaxSeq = 1<<12 - 1
+
+	// uuid7RandBytes is the number of random bytes consumed by a single UUID v7
+	uuid7RandBytes = 8
+
+	// uuid7PoolSize is the number of random bytes read from the entropy source at once
+	uuid7PoolSize = 256 * uuid7RandBytes
+)
+
+// DefaultUUID7Generator is the UUID7Generator used with the wall clock
+var DefaultUUID7Generator = NewUUID7Generator(time.Now)
+
+// UUID7Generator produces time ordered version 7 UUIDs in bulk.
+//
+// UUIDs generated within the same millisecond carry an increasing 12 bits counter
+// (method 1 of RFC 9562, section 6.2), so that they keep sorting in generation order.
+// When the counter is exhausted, the timestamp is advanced by one millisecond ahead of the clock.
+// The random part is drawn from a pool of pre-fetched random bytes.
+//
+// A UUID7Generator is safe for concurrent use.
+type UUID7Generator struct {
+	mu    sync.Mutex
+	clock func() time.Time
+	ms    int64
+	seq   uint16
+	pool  [uuid7PoolSize]byte
+	pos   int
+}
+
+// NewUUID7Generator builds a UUID7Generator reading the current time from clock.
+//
+// A nil clock defaults to time.Now.
+func NewUUID7Generator(clock func() time.Time) *UUID7Generator {
+	if clock == nil {
+		clock = time.Now
+	}
+	return &UUID7Generator{
+		clock: clock,
+		pos:   uuid7PoolSize,
+	}
+}
+
+// Next generates a new UUID7, which sorts after any UUID7 previously produced by this generator
+func (g *UUID7Generator) Next() (UUID7, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.pos+uuid7RandBytes > uuid7PoolSize {
+		if _, err := io.ReadFull(rand.Reader, g.pool[:]); err != nil {
+			return "", err
+		}
+		g.pos = 0
+	}
+	random := g.pool[g.pos : g.pos+uuid7RandBytes]
+	g.pos += uuid7RandBytes
+
+	now := g.clock().UnixMilli()
+	switch {
+	case now > g.ms:
+		g.ms = now
+		g.seq = 0
+	case g.seq < uuid7MaxSeq:
+		g.seq++
+	default:
+		g.ms++
+		g.seq = 0
+	}
+
+	var id uuid.UUID
+	id[0] = byte(g.ms >> 40)
+	id[1] = byte(g.ms >> 32)
+	id[2] = byte(g.ms >> 24)
+	id[3] = byte(g.ms >> 16)
+	id[4] = byte(g.ms >> 8)
+	id[5] = byte(g.ms)
+	id[6] = 0x70 | byte(g.seq>>8)
+	id[7] = byte(g.seq)
+	copy(id[8:], random)
+	id[8] = 0x80 | id[8]&0x3f // RFC 9562 variant
+
+	return UUID7(id.String()), nil
+}
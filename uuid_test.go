@@ -0,0 +1,275 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUID_Bytes(t *testing.T) {
+	const str = "a8098c1a-f86e-11da-bd1a-00112444be1e"
+	raw := [16]byte(uuid.MustParse(str))
+
+	b, err := UUID(str).ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, raw, b)
+	assert.Equal(t, UUID(str), UUIDFromBytes(b))
+
+	b, err = UUID("A8098C1AF86E11DABD1A00112444BE1E").ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, raw, b)
+
+	_, err = UUID("not-a-uuid").ToBytes()
+	require.Error(t, err)
+
+	u3 := NewUUID3(NameSpaceDNS, "python.org")
+	b, err = u3.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, u3, UUID3FromBytes(b))
+
+	u4 := UUID4(uuid.Must(uuid.NewRandom()).String())
+	b, err = u4.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, u4, UUID4FromBytes(b))
+
+	u5 := NewUUID5(NameSpaceDNS, "python.org")
+	b, err = u5.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, u5, UUID5FromBytes(b))
+
+	u6, err := NewUUID6()
+	require.NoError(t, err)
+	b, err = u6.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, u6, UUID6FromBytes(b))
+
+	u7, err := NewUUID7()
+	require.NoError(t, err)
+	b, err = u7.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, u7, UUID7FromBytes(b))
+}
+
+func TestUUID_ScanBinary(t *testing.T) {
+	for _, tc := range []struct {
+		format string
+		value  string
+		scan   func(interface{}) (string, error)
+	}{
+		{"uuid", "a8098c1a-f86e-11da-bd1a-00112444be1e", func(raw interface{}) (string, error) {
+			var u UUID
+			err := u.Scan(raw)
+			return string(u), err
+		}},
+		{"uuid3", "6fa459ea-ee8a-3ca4-894e-db77e160355e", func(raw interface{}) (string, error) {
+			var u UUID3
+			err := u.Scan(raw)
+			return string(u), err
+		}},
+		{"uuid4", "f47ac10b-58cc-4372-a567-0e02b2c3d479", func(raw interface{}) (string, error) {
+			var u UUID4
+			err := u.Scan(raw)
+			return string(u), err
+		}},
+		{"uuid5", "886313e1-3b8a-5372-9b90-0c9aee199e5d", func(raw interface{}) (string, error) {
+			var u UUID5
+			err := u.Scan(raw)
+			return string(u), err
+		}},
+		{"uuid6", "1ec9414c-232a-6b00-b3c8-9f6bdeced846", func(raw interface{}) (string, error) {
+			var u UUID6
+			err := u.Scan(raw)
+			return string(u), err
+		}},
+		{"uuid7", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", func(raw interface{}) (string, error) {
+			var u UUID7
+			err := u.Scan(raw)
+			return string(u), err
+		}},
+	} {
+		raw := [16]byte(uuid.MustParse(tc.value))
+
+		scanned, err := tc.scan(raw)
+		require.NoError(t, err)
+		assert.Equalf(t, tc.value, scanned, "unexpected %s scanned from [16]byte", tc.format)
+
+		scanned, err = tc.scan(raw[:])
+		require.NoError(t, err)
+		assert.Equalf(t, tc.value, scanned, "unexpected %s scanned from []byte", tc.format)
+		testValid(t, tc.format, scanned)
+
+		// textual representations are still supported
+		scanned, err = tc.scan([]byte(tc.value))
+		require.NoError(t, err)
+		assert.Equal(t, tc.value, scanned)
+		scanned, err = tc.scan(tc.value)
+		require.NoError(t, err)
+		assert.Equal(t, tc.value, scanned)
+
+		_, err = tc.scan([4]byte{})
+		require.Error(t, err)
+	}
+}
+
+func TestUUID_Base64(t *testing.T) {
+	u := UUID("a8098c1a-f86e-11da-bd1a-00112444be1e")
+	encoded := u.ToBase64()
+	assert.Equal(t, "qAmMGvhuEdq9GgARJES-Hg", encoded)
+	assert.Len(t, encoded, 22)
+
+	decoded, err := UUIDFromBase64(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, u, decoded)
+
+	assert.Empty(t, UUID("not-a-uuid").ToBase64())
+	_, err = UUIDFromBase64("qAmMGvhuEdq9GgARJES")
+	require.Error(t, err)
+	_, err = UUIDFromBase64("qAmMGvhuEdq9GgARJES+Hg")
+	require.Error(t, err)
+}
+
+func TestUUID6_Time(t *testing.T) {
+	before := time.Now().Add(-time.Millisecond)
+	u6, err := NewUUID6()
+	require.NoError(t, err)
+	after := time.Now().Add(time.Millisecond)
+
+	ts, err := u6.Time()
+	require.NoError(t, err)
+	assert.True(t, ts.After(before) && ts.Before(after), "unexpected UUID v6 time: %v", ts)
+
+	// reference value from RFC 9562, appendix A.5
+	ts, err = UUID6("1EC9414C-232A-6B00-B3C8-9F6BDECED846").Time()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC), ts)
+
+	_, err = UUID6("not-a-uuid").Time()
+	require.Error(t, err)
+	_, err = UUID6(uuid.Must(uuid.NewV7()).String()).Time()
+	require.Error(t, err)
+}
+
+func TestUUID6_Less(t *testing.T) {
+	const (
+		first  = UUID6("1ec9414c-232a-6b00-b3c8-9f6bdeced846")
+		second = UUID6("1ec9414c-232a-6b01-83c8-9f6bdeced846")
+	)
+
+	assert.True(t, first.Less(second))
+	assert.False(t, second.Less(first))
+	assert.False(t, first.Less(first))
+
+	// the case and dashes are ignored
+	upper := UUID6(strings.ToUpper(string(second)))
+	assert.True(t, first.Less(upper))
+	assert.False(t, upper.Less(second))
+	assert.True(t, first.Less(UUID6(strings.ReplaceAll(string(second), "-", ""))))
+
+	assert.True(t, UUID6("a").Less("b"))
+
+	generated, err := NewUUID6()
+	require.NoError(t, err)
+	assert.True(t, first.Less(generated))
+
+}
+
+func TestUUID7Generator(t *testing.T) {
+	now := time.Date(2024, 9, 18, 10, 30, 0, 0, time.UTC)
+	g := NewUUID7Generator(func() time.Time { return now })
+
+	var previous UUID7
+	for i := 0; i < 2*(uuid7MaxSeq+1)+10; i++ {
+		id, err := g.Next()
+		require.NoError(t, err)
+		require.True(t, IsUUID7(string(id)), "generated an invalid UUID v7: %s", id)
+		require.Greater(t, string(id), string(previous))
+		previous = id
+	}
+
+	// exhausting the counter advances the timestamp beyond the frozen clock
+	b, err := previous.ToBytes()
+	require.NoError(t, err)
+	sec, nsec := uuid.UUID(b).Time().UnixTime()
+	assert.Equal(t, now.Add(2*time.Millisecond), time.Unix(sec, nsec).UTC())
+
+	// a clock going backwards does not break ordering
+	now = now.Add(-time.Hour)
+	id, err := g.Next()
+	require.NoError(t, err)
+	assert.Greater(t, string(id), string(previous))
+
+	// a new millisecond resets the counter
+	now = now.Add(2 * time.Hour)
+	id, err = g.Next()
+	require.NoError(t, err)
+	b, err = id.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x70), b[6])
+	assert.Equal(t, byte(0), b[7])
+	sec, nsec = uuid.UUID(b).Time().UnixTime()
+	assert.Equal(t, now, time.Unix(sec, nsec).UTC())
+}
+
+func TestUUID7Generator_Concurrent(t *testing.T) {
+	const workers, count = 8, 500
+	g := NewUUID7Generator(nil)
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		seen = make(map[UUID7]struct{}, workers*count)
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				id, err := g.Next()
+				assert.NoError(t, err)
+				mu.Lock()
+				seen[id] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, seen, workers*count)
+}
+
+func BenchmarkUUID7(b *testing.B) {
+	b.Run("UUID7Generator", func(b *testing.B) {
+		g := NewUUID7Generator(time.Now)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = g.Next()
+		}
+	})
+
+	// the generator returns formatted UUIDs, so the baseline formats them as well
+	b.Run("uuid.NewV7", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			id, _ := uuid.NewV7()
+			_ = id.String()
+		}
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formatReasons explains why values are rejected by the validators of the built-in formats
+var formatReasons = map[string]string{
+	"asn":               "must be an AS number between 1 and 4294967295",
+	"bic":               "must be an ISO 9362 business identifier code (SWIFT code)",
+	"bsonobjectid":      "must be a BSON ObjectId of 24 hexadecimal characters",
+	"byte":              "must be base64 encoded",
+	"byte-std":          "must be base64 encoded with the standard alphabet",
+	"byte-url":          "must be base64 encoded with the URL-safe alphabet",
+	"cidr":              "must be an IP address and prefix length in CIDR notation",
+	"cidr-list":         "must be a list of CIDRs separated by commas or new lines",
+	"creditcard":        "must be a valid credit card number",
+	"cron":              "must be a cron expression with 5 or 6 fields",
+	"data-url":          "must be an RFC 2397 data URL",
+	"date":              "must be an RFC 3339 full-date (e.g. 2006-01-02)",
+	"datetime":          "must be an RFC 3339 date-time (e.g. 2006-01-02T15:04:05Z)",
+	"duration":          `must be a duration (e.g. "3 weeks", "1ms")`,
+	"email":             "must be a valid RFC 5322 email address",
+	"email-list":        "must be a comma-separated list of RFC 5322 email addresses",
+	"epoch":             "must be an integer unix timestamp",
+	"epoch-millis":      "must be an integer unix timestamp",
+	"epoch-nanos":       "must be an integer unix timestamp",
+	"geo-coordinate":    `must be a latitude and longitude pair (e.g. "48.8566,2.3522")`,
+	"hex":               "must be an even number of hexadecimal characters",
+	"hexcolor":          `must be a hexadecimal color (e.g. "#FFFFFF")`,
+	"hostname":          "must be a valid RFC 1034 hostname",
+	"hostname-list":     "must be a comma-separated list of RFC 1034 hostnames",
+	"hslcolor":          `must be an HSL color (e.g. "hsl(270,50%,40%)")`,
+	"idn-email":         "must be a valid RFC 6531 internationalized email address",
+	"idn-hostname":      "must be a valid IDNA2008 internationalized hostname",
+	"ipv4":              "must be a valid IPv4 address",
+	"ipv6":              "must be a valid IPv6 address",
+	"iri-reference":     "must be a valid RFC 3987 IRI reference",
+	"isbn":              "must be a valid ISBN-10 or ISBN-13",
+	"isbn10":            "must be a valid ISBN-10",
+	"isbn13":            "must be a valid ISBN-13",
+	"json-pointer":      "must be a valid RFC 6901 JSON pointer",
+	"mac":               "must be a valid MAC address",
+	"mime-type":         "must be a media type with a top-level type registered by IANA",
+	"network-address":   `must be a host and a port (e.g. "example.com:8080")`,
+	"port":              "must be a port number between 0 and 65535",
+	"regex":             "must be a valid regular expression",
+	"rgbcolor":          `must be an RGB color (e.g. "rgb(100,100,100)")`,
+	"ssn":               `must be a US social security number as "123-45-6789", "123 45 6789" or "123456789", without mixing separators`,
+	"timezone":          "must be a known IANA time zone name",
+	"ulid":              "must be a valid ULID",
+	"uri":               "must be an absolute URI or an absolute path",
+	"uri-reference":     "must be a valid RFC 3986 URI reference",
+	"uri-template":      "must be a valid RFC 6570 URI template",
+	"uuid":              "must be a valid UUID",
+	"uuid3":             "must be a valid version 3 UUID",
+	"uuid4":             "must be a valid version 4 UUID",
+	"uuid5":             "must be a valid version 5 UUID",
+	"uuid6":             "must be a valid version 6 UUID",
+	"uuid7":             "must be a valid version 7 UUID",
+	"wildcard-hostname": "must be a hostname, with an optional leading wildcard label",
+}
+
+// validationReason explains why data is not valid against the format registered under the specified name.
+//
+// Values which are well-formed but fail a checksum are reported as such.
+func validationReason(name, data string) string {
+	if isChecksumMismatch(name, data) {
+		return "checksum mismatch"
+	}
+	if reason, ok := formatReasons[name]; ok {
+		return reason
+	}
+	return fmt.Sprintf("must be a valid %s", name)
+}
+
+// isChecksumMismatch returns true when data has the shape of an ISBN or credit card number with a wrong check digit
+func isChecksumMismatch(name, data string) bool {
+	digits := isbnSeparators.Replace(data)
+	switch name {
+	case "isbn", "isbn10", "isbn13":
+		switch {
+		case len(digits) == 10 && name != "isbn13":
+			return isDigits(digits[:9]) && (isDigits(digits[9:]) || strings.ContainsAny(digits[9:], "Xx")) && !LuhnISBN10(digits)
+		case len(digits) == 13 && name != "isbn10":
+			check, err := EAN13CheckDigit(digits[:12])
+			return err == nil && isDigits(digits[12:]) && check != digits[12]
+		}
+	case "creditcard":
+		return len(digits) >= 12 && len(digits) <= 19 && isDigits(digits) && !LuhnCheck(digits)
+	}
+	return false
+}
+
+// isDigits returns true when the string is made of ASCII digits only
+func isDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return str != ""
+}
+
+// ValidationError explains why a value is not valid against a string format
+type ValidationError struct {
+	Format string
+	Value  string
+	Reason string
+
+	// err is the error which caused the validation failure, if any
+	err error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%q is not a valid %s: %s", e.Value, e.Format, e.Reason)
+}
+
+// Unwrap returns the error which caused the validation failure, if any
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
+// ValidationErrors collects the failures of a batch validation
+type ValidationErrors []ValidationError
+
+// Error joins the messages of all validation errors
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for i := range e {
+		msgs = append(msgs, e[i].Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the validation errors as a slice of errors, to support errors.Is and errors.As
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for i := range e {
+		errs = append(errs, &e[i])
+	}
+	return errs
+}
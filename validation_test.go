@@ -0,0 +1,103 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatRegistry_ValidateWithError(t *testing.T) {
+	registry := asFormatRegistry(t, NewFormats())
+
+	assert.Nil(t, registry.ValidateWithError("email", "somebody@example.com"))
+	assert.Nil(t, registry.ValidateWithError("date-time", "2012-03-02T15:06:05.999Z"))
+
+	for _, tc := range []struct {
+		format, value, reason string
+	}{
+		{"email", "not an email", "must be a valid RFC 5322 email address"},
+		{"date-time", "yesterday", "must be an RFC 3339 date-time (e.g. 2006-01-02T15:04:05Z)"},
+		{"isbn", "0-306-40615-3", "checksum mismatch"},
+		{"isbn10", "0306406153", "checksum mismatch"},
+		{"isbn13", "978-0-306-40615-8", "checksum mismatch"},
+		{"isbn", "not an isbn", "must be a valid ISBN-10 or ISBN-13"},
+		{"creditcard", "4111 1111 1111 1112", "checksum mismatch"},
+		{"creditcard", "4111", "must be a valid credit card number"},
+		{"ssn", "123-45 6789", `must be a US social security number as "123-45-6789", "123 45 6789" or "123456789", without mixing separators`},
+		{"unknown-format", "anything", "must be a valid unknown-format"},
+	} {
+		verr := registry.ValidateWithError(tc.format, tc.value)
+		require.NotNilf(t, verr, "expected %q to be an invalid %s", tc.value, tc.format)
+		assert.Equal(t, tc.format, verr.Format)
+		assert.Equal(t, tc.value, verr.Value)
+		assert.Equal(t, tc.reason, verr.Reason)
+	}
+
+	t.Run("custom formats have a default reason", func(t *testing.T) {
+		registry.Add("custom", new(testFormat), func(string) bool { return false })
+		verr := registry.ValidateWithError("custom", "anything")
+		require.NotNil(t, verr)
+		assert.Equal(t, "must be a valid custom", verr.Reason)
+		assert.EqualError(t, verr, `"anything" is not a valid custom: must be a valid custom`)
+	})
+
+	t.Run("built-in formats all have a reason", func(t *testing.T) {
+		for _, name := range Default.(*defaultFormats).names() {
+			if name == "password" || name == "test-format" {
+				continue
+			}
+			assert.Containsf(t, formatReasons, name, "missing validation reason for format %q", name)
+		}
+	})
+}
+
+func TestValidationErrors(t *testing.T) {
+	errs := ValidationErrors{
+		{Format: "email", Value: "a", Reason: "must be a valid RFC 5322 email address"},
+		{Format: "uuid", Value: "b", Reason: "must be a valid UUID"},
+	}
+	assert.EqualError(t, errs, `"a" is not a valid email: must be a valid RFC 5322 email address; "b" is not a valid uuid: must be a valid UUID`)
+
+	var err error = errs
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "email", verr.Format)
+}
+
+func TestDecodeHook_ValidationError(t *testing.T) {
+	registry := asFormatRegistry(t, NewFormats())
+	hook, ok := registry.MapStructureHookFunc().(mapstructure.DecodeHookFuncType)
+	require.True(t, ok)
+
+	_, err := hook(reflect.TypeOf(""), reflect.TypeOf(DateTime{}), "not a date")
+	require.Error(t, err)
+
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "datetime", verr.Format)
+	assert.Equal(t, "not a date", verr.Value)
+	assert.NotEmpty(t, verr.Reason)
+	require.Error(t, errors.Unwrap(verr), "the parsing error should be wrapped")
+
+	_, err = hook(reflect.TypeOf(""), reflect.TypeOf(new(Duration)), "forever")
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "duration", verr.Format)
+}
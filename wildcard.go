@@ -0,0 +1,174 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func init() {
+	wh := WildcardHostname("")
+	// register this format in the default registry
+	addBuiltin("wildcard-hostname", &wh, IsWildcardHostname)
+}
+
+// IsWildcardHostname returns true when the string is a hostname, with an optional "*" as its leftmost label
+// (e.g. "*.example.com"), as used in the subject alternative names of TLS certificates.
+//
+// Partial wildcards (e.g. "f*.example.com"), wildcards in other labels (e.g. "foo.*.example.com")
+// and a plain "*" without a domain are rejected.
+func IsWildcardHostname(str string) bool {
+	if domain, found := strings.CutPrefix(str, "*."); found {
+		return domain != "" && !strings.Contains(domain, "*") && IsHostname(domain)
+	}
+	return !strings.Contains(str, "*") && IsHostname(str)
+}
+
+// WildcardHostname represents a hostname which may start with a wildcard label (e.g. "*.example.com")
+//
+// swagger:strfmt wildcard-hostname
+type WildcardHostname string
+
+// Matches returns true when the hostname is covered by this wildcard hostname.
+//
+// As specified by RFC 2818, the wildcard matches a single label: "*.example.com" matches "www.example.com"
+// but neither "example.com" nor "a.b.example.com". Hostnames are compared case-insensitively,
+// and regardless of any trailing dot.
+func (w WildcardHostname) Matches(h Hostname) bool {
+	if !IsWildcardHostname(string(w)) {
+		return false
+	}
+
+	// fully qualified domain names match their relative form
+	pattern := strings.TrimSuffix(string(w), ".")
+	hostname := string(h.StripTrailingDot())
+
+	domain, wildcard := strings.CutPrefix(pattern, "*.")
+	if !wildcard {
+		return strings.EqualFold(pattern, hostname)
+	}
+
+	label, rest, found := strings.Cut(hostname, ".")
+	return found && label != "" && strings.EqualFold(domain, rest)
+}
+
+// MatchesAny returns true when any of the hostnames is covered by this wildcard hostname
+func (w WildcardHostname) MatchesAny(hostnames []Hostname) bool {
+	for _, h := range hostnames {
+		if w.Matches(h) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true when this hostname is covered by the pattern, which may start with a wildcard label.
+//
+// See WildcardHostname.Matches.
+func (h Hostname) Matches(pattern WildcardHostname) bool {
+	return pattern.Matches(h)
+}
+
+// MarshalText turns this instance into text
+func (w WildcardHostname) MarshalText() ([]byte, error) {
+	return []byte(string(w)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (w *WildcardHostname) UnmarshalText(data []byte) error { // validation is performed later on
+	*w = WildcardHostname(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (w *WildcardHostname) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*w = WildcardHostname(string(v))
+	case string:
+		*w = WildcardHostname(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.WildcardHostname from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (w WildcardHostname) Value() (driver.Value, error) {
+	return driver.Value(string(w)), nil
+}
+
+func (w WildcardHostname) String() string {
+	return string(w)
+}
+
+// MarshalJSON returns the WildcardHostname as JSON
+func (w WildcardHostname) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(w))
+}
+
+// UnmarshalJSON sets the WildcardHostname from JSON
+func (w *WildcardHostname) UnmarshalJSON(data []byte) error {
+	if string(data) == jsonNull {
+		return nil
+	}
+	var wstr string
+	if err := json.Unmarshal(data, &wstr); err != nil {
+		return err
+	}
+	*w = WildcardHostname(wstr)
+	return nil
+}
+
+// MarshalBSON document from this value
+func (w WildcardHostname) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(bson.M{"data": w.String()})
+}
+
+// UnmarshalBSON document into this value
+func (w *WildcardHostname) UnmarshalBSON(data []byte) error {
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	if ud, ok := doc["data"].(string); ok {
+		*w = WildcardHostname(ud)
+		return nil
+	}
+	return errors.New("couldn't unmarshal bson bytes as WildcardHostname")
+}
+
+// DeepCopyInto copies the receiver and writes its value into out.
+func (w *WildcardHostname) DeepCopyInto(out *WildcardHostname) {
+	*out = *w
+}
+
+// DeepCopy copies the receiver into a new WildcardHostname.
+func (w *WildcardHostname) DeepCopy() *WildcardHostname {
+	if w == nil {
+		return nil
+	}
+	out := new(WildcardHostname)
+	w.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,101 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatWildcardHostname(t *testing.T) {
+	wh := WildcardHostname("*.example.com")
+	str := "*.example.org"
+	validWildcards := []string{
+		"*.example.com",
+		"*.com",
+		"*.sub.example.com",
+		"example.com",
+		"www.example.com",
+		"localhost",
+	}
+	invalidWildcards := []string{
+		"",
+		"*",
+		"*.",
+		"**.example.com",
+		"*.*.example.com",
+		"foo.*.example.com",
+		"f*.example.com",
+		"*foo.example.com",
+		"example.*",
+		"*.exa mple.com",
+		"*..example.com",
+	}
+
+	testStringFormat(t, &wh, "wildcard-hostname", str, validWildcards, invalidWildcards)
+}
+
+func TestWildcardHostname_Matches(t *testing.T) {
+	for _, tc := range []struct {
+		wildcard WildcardHostname
+		hostname Hostname
+		matches  bool
+	}{
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "WWW.Example.COM", true},
+		{"*.example.com", "api.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "a.b.example.com", false},
+		{"*.example.com", ".example.com", false},
+		{"*.example.com", "www.example.org", false},
+		{"*.example.com", "wwwexample.com", false},
+		{"*.example.com", "www.example.com.", true},
+		{"*.example.com.", "www.example.com", true},
+		{"*.example.com.", "example.com.", false},
+		{"www.example.com", "www.example.com", true},
+		{"www.example.com", "WWW.EXAMPLE.COM", true},
+		{"www.example.com", "api.example.com", false},
+		{"www.example.com.", "www.example.com", true},
+		{"**.example.com", "www.example.com", false},
+		{"*", "localhost", false},
+	} {
+		assert.Equalf(t, tc.matches, tc.wildcard.Matches(tc.hostname), "unexpected match of %q against %q", tc.hostname, tc.wildcard)
+		assert.Equalf(t, tc.matches, tc.hostname.Matches(tc.wildcard), "unexpected match of %q against %q", tc.hostname, tc.wildcard)
+	}
+}
+
+func TestWildcardHostname_MatchesAny(t *testing.T) {
+	wh := WildcardHostname("*.example.com")
+	assert.True(t, wh.MatchesAny([]Hostname{"example.com", "www.example.com"}))
+	assert.False(t, wh.MatchesAny([]Hostname{"example.com", "a.b.example.com"}))
+	assert.False(t, wh.MatchesAny(nil))
+}
+
+func TestDeepCopyWildcardHostname(t *testing.T) {
+	wh := WildcardHostname("*.example.com")
+	in := &wh
+
+	out := new(WildcardHostname)
+	in.DeepCopyInto(out)
+	assert.Equal(t, in, out)
+
+	out2 := in.DeepCopy()
+	assert.Equal(t, in, out2)
+
+	var inNil *WildcardHostname
+	out3 := inNil.DeepCopy()
+	assert.Nil(t, out3)
+}